@@ -0,0 +1,16 @@
+locals {
+  moroz_rules = provider::zentral::santa_rules_from("moroz", file("${path.module}/moroz.toml"))
+}
+
+resource "zentral_santa_rule" "moroz" {
+  for_each = { for rule in local.moroz_rules : "${rule.target_type}/${rule.target_identifier}" => rule }
+
+  configuration_id  = zentral_santa_configuration.default.id
+  policy            = each.value.policy
+  cel_expr          = each.value.cel_expr
+  target_type       = each.value.target_type
+  target_identifier = each.value.target_identifier
+  description       = each.value.description
+  custom_message    = each.value.custom_message
+  custom_url        = each.value.custom_url
+}
@@ -0,0 +1,14 @@
+resource "zentral_mdm_blueprint_artifact" "canary" {
+  blueprint_id  = zentral_mdm_blueprint.default.id
+  artifact_id   = zentral_mdm_artifact.canary.id
+  macos         = true
+  shard_modulo  = 100
+  default_shard = 10
+}
+
+check "canary_in_scope" {
+  assert {
+    condition     = provider::zentral::shard("C02ABCDEFGH", zentral_mdm_artifact.canary.id, 100) < 10
+    error_message = "The canary Mac is not in scope of the artifact."
+  }
+}
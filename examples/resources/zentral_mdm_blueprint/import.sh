@@ -0,0 +1,5 @@
+# An MDM blueprint can be imported using its ID
+terraform import zentral_mdm_blueprint.example 42
+
+# or using its name
+terraform import zentral_mdm_blueprint.example "name:Default"
@@ -0,0 +1,5 @@
+# An MDM blueprint artifact can be imported using its ID
+terraform import zentral_mdm_blueprint_artifact.example 42
+
+# or using <blueprint_id>/<artifact_id>
+terraform import zentral_mdm_blueprint_artifact.example 1/0ae1ab45-6b06-4306-b78b-75ad6ae9ad6e
@@ -0,0 +1,13 @@
+resource "zentral_mdm_artifact" "screensaver" {
+  name      = "Screensaver"
+  type      = "Profile"
+  channel   = "Device"
+  platforms = ["macOS"]
+}
+
+resource "zentral_mdm_profile" "screensaver" {
+  artifact_id = zentral_mdm_artifact.screensaver.id
+  content     = "${path.module}/profiles/screensaver.mobileconfig"
+  macos       = true
+  version     = 1
+}
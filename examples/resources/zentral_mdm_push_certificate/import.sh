@@ -0,0 +1,5 @@
+# An MDM push certificate can be imported using its ID
+terraform import zentral_mdm_push_certificate.example 42
+
+# or using its name
+terraform import zentral_mdm_push_certificate.example "name:Default"
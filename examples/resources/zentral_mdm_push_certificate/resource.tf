@@ -0,0 +1,3 @@
+resource "zentral_mdm_push_certificate" "default" {
+  name = "Default"
+}
@@ -0,0 +1,5 @@
+# A meta business unit can be imported using its ID
+terraform import zentral_meta_business_unit.example 42
+
+# or using its name
+terraform import zentral_meta_business_unit.example "name:Default"
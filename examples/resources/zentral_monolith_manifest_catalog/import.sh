@@ -0,0 +1,5 @@
+# A Monolith manifest catalog can be imported using its ID
+terraform import zentral_monolith_manifest_catalog.example 42
+
+# or using <manifest_id>/<catalog_id>
+terraform import zentral_monolith_manifest_catalog.example 1/2
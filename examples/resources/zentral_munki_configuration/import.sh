@@ -0,0 +1,5 @@
+# A Munki configuration can be imported using its ID
+terraform import zentral_munki_configuration.example 42
+
+# or using its name
+terraform import zentral_munki_configuration.example "name:Default"
@@ -0,0 +1,5 @@
+# An osquery configuration can be imported using its ID
+terraform import zentral_osquery_configuration.example 42
+
+# or using its name
+terraform import zentral_osquery_configuration.example "name:Default"
@@ -0,0 +1,5 @@
+# A Santa configuration can be imported using its ID
+terraform import zentral_santa_configuration.example 42
+
+# or using its name
+terraform import zentral_santa_configuration.example "name:Default"
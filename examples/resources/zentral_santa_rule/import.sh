@@ -0,0 +1,5 @@
+# A Santa rule can be imported using its ID
+terraform import zentral_santa_rule.example 42
+
+# or using <configuration_id>/<target_type>/<target_identifier>
+terraform import zentral_santa_rule.example 1/TEAMID/EQHXZ8M8AV
@@ -0,0 +1,6 @@
+# The Santa rules of a configuration can be imported using the ID of the configuration.
+# All the rules of the configuration that are not part of a Zentral Santa ruleset are adopted.
+terraform import zentral_santa_rules_bulk.example 1
+
+# or using the ID of the configuration and the IDs of the existing rules to adopt.
+terraform import zentral_santa_rules_bulk.example 1/42,43,44
@@ -0,0 +1,22 @@
+resource "zentral_santa_configuration" "default" {
+  name = "Default"
+}
+
+resource "zentral_santa_rules_bulk" "default" {
+  configuration_id = zentral_santa_configuration.default.id
+
+  rules = [
+    {
+      policy            = "ALLOWLIST"
+      target_type       = "TEAMID"
+      target_identifier = "EQHXZ8M8AV"
+      description       = "Google"
+    },
+    {
+      policy            = "BLOCKLIST"
+      target_type       = "SIGNINGID"
+      target_identifier = "platform:com.apple.Chess"
+      custom_message    = "No chess during working hours"
+    },
+  ]
+}
@@ -0,0 +1,5 @@
+# A tag can be imported using its ID
+terraform import zentral_tag.example 42
+
+# or using its name
+terraform import zentral_tag.example "name:Yolo"
@@ -0,0 +1,5 @@
+# A taxonomy can be imported using its ID
+terraform import zentral_taxonomy.example 42
+
+# or using its name
+terraform import zentral_taxonomy.example "name:Fomo"
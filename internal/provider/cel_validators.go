@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb" // registers google/protobuf/timestamp.proto
+)
+
+// santaCELFileDescriptorTextproto is the descriptor of the santa.cel.v1
+// package, in the protobuf text format.
+//
+//go:embed santa_cel_v1.textproto
+var santaCELFileDescriptorTextproto []byte
+
+// santaCELFileDescriptor returns the descriptor of the santa.cel.v1 package.
+func santaCELFileDescriptor() (protoreflect.FileDescriptor, error) {
+	var fdp descriptorpb.FileDescriptorProto
+	if err := prototext.Unmarshal(santaCELFileDescriptorTextproto, &fdp); err != nil {
+		return nil, err
+	}
+	return protodesc.NewFile(&fdp, protoregistry.GlobalFiles)
+}
+
+// santaCELEnv returns the CEL environment of the Santa CEL rules. The fields
+// of the santa.cel.v1.ExecutionContext message are the variables, and the
+// santa.cel.v1.ReturnValue enum values can be returned instead of a boolean.
+// They are only declared, because the expressions are type checked, not
+// evaluated.
+var santaCELEnv = sync.OnceValues(func() (*cel.Env, error) {
+	fd, err := santaCELFileDescriptor()
+	if err != nil {
+		return nil, err
+	}
+	opts := []cel.EnvOption{
+		cel.TypeDescs(fd),
+		cel.DeclareContextProto(fd.Messages().ByName("ExecutionContext")),
+	}
+	returnValues := fd.Enums().ByName("ReturnValue").Values()
+	for i := 0; i < returnValues.Len(); i++ {
+		if returnValue := returnValues.Get(i); returnValue.Number() != 0 {
+			opts = append(opts, cel.Variable(string(returnValue.Name()), cel.IntType))
+		}
+	}
+	return cel.NewEnv(opts...)
+})
+
+// probeActionCELEnv returns the CEL environment of the HTTP POST probe action
+// transformations. The input is a map with the event metadata and payload.
+var probeActionCELEnv = sync.OnceValues(func() (*cel.Env, error) {
+	return cel.NewEnv(
+		cel.Variable("metadata", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("payload", cel.MapType(cel.StringType, cel.DynType)),
+	)
+})
+
+// CEL expression validator
+
+var _ validator.String = celExpressionValidator{}
+
+// celExpressionValidator compiles a CEL expression in a CEL environment, and
+// optionally checks its output type.
+type celExpressionValidator struct {
+	env         func() (*cel.Env, error)
+	envName     string
+	outputTypes []*cel.Type
+}
+
+// santaRuleCELExprValidator returns a validator compiling the Santa CEL
+// expressions, that must return a boolean or a policy.
+func santaRuleCELExprValidator() celExpressionValidator {
+	return celExpressionValidator{
+		env:         santaCELEnv,
+		envName:     "Santa",
+		outputTypes: []*cel.Type{cel.BoolType, cel.IntType},
+	}
+}
+
+// probeActionCELTransformationValidator returns a validator compiling the
+// HTTP POST probe action transformations.
+func probeActionCELTransformationValidator() celExpressionValidator {
+	return celExpressionValidator{
+		env:     probeActionCELEnv,
+		envName: "probe action",
+	}
+}
+
+func (v celExpressionValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v celExpressionValidator) MarkdownDescription(_ context.Context) string {
+	return fmt.Sprintf("value must be a valid %s CEL expression", v.envName)
+}
+
+func (v celExpressionValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || req.ConfigValue.ValueString() == "" {
+		return
+	}
+
+	env, err := v.env()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"CEL environment error",
+			fmt.Sprintf("Unable to create the %s CEL environment: %s", v.envName, err),
+		)
+		return
+	}
+
+	ast, iss := env.Compile(req.ConfigValue.ValueString())
+	if iss.Err() != nil {
+		for _, e := range iss.Errors() {
+			// the CEL columns are 0-based
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Invalid CEL expression",
+				fmt.Sprintf("Line %d, column %d: %s", e.Location.Line(), e.Location.Column()+1, e.Message),
+			)
+		}
+		return
+	}
+
+	if len(v.outputTypes) == 0 || ast.OutputType().IsExactType(cel.DynType) {
+		return
+	}
+	for _, outputType := range v.outputTypes {
+		if ast.OutputType().IsExactType(outputType) {
+			return
+		}
+	}
+	var outputTypeNames []string
+	for _, outputType := range v.outputTypes {
+		outputTypeNames = append(outputTypeNames, outputType.String())
+	}
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid CEL expression",
+		fmt.Sprintf("The %s CEL expression must return a value of type %s, not %s.", v.envName, strings.Join(outputTypeNames, " or "), ast.OutputType()),
+	)
+}
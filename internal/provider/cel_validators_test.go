@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCELExpressionValidators(t *testing.T) {
+	cases := []struct {
+		name      string
+		validator validator.String
+		value     types.String
+		errors    []string
+	}{
+		{"empty santa expression", santaRuleCELExprValidator(), types.StringValue(""), nil},
+		{"unknown santa expression", santaRuleCELExprValidator(), types.StringUnknown(), nil},
+		{
+			"santa signing time",
+			santaRuleCELExprValidator(),
+			types.StringValue("target.signing_time >= timestamp('2025-05-31T00:00:00Z')"),
+			nil,
+		},
+		{
+			"santa policy",
+			santaRuleCELExprValidator(),
+			types.StringValue("'--inspect' in args || envs['ELECTRON_RUN_AS_NODE'] == '1' ? BLOCKLIST : ALLOWLIST"),
+			nil,
+		},
+		{
+			"santa touch ID policy",
+			santaRuleCELExprValidator(),
+			types.StringValue("target.signing_time >= timestamp('2025-05-31T00:00:00Z') ? REQUIRE_TOUCHID : BLOCKLIST"),
+			nil,
+		},
+		{
+			"santa syntax error",
+			santaRuleCELExprValidator(),
+			types.StringValue("target.signing_time >= "),
+			[]string{"Line 1, column 24: Syntax error:"},
+		},
+		{
+			"santa user and working directory",
+			santaRuleCELExprValidator(),
+			types.StringValue("euid == 0 && cwd.startsWith('/tmp') ? REQUIRE_TOUCHID_ONLY : ALLOWLIST"),
+			nil,
+		},
+		{
+			"santa unknown variable",
+			santaRuleCELExprValidator(),
+			types.StringValue("target.signing_time >= timestamp('2025-05-31T00:00:00Z') && uid == 0"),
+			[]string{"Line 1, column 61: undeclared reference to 'uid'"},
+		},
+		{
+			"santa unknown field",
+			santaRuleCELExprValidator(),
+			types.StringValue("target.signature_time > timestamp('2025-05-31T00:00:00Z')"),
+			[]string{"Line 1, column 7: undefined field 'signature_time'"},
+		},
+		{
+			"santa type error",
+			santaRuleCELExprValidator(),
+			types.StringValue("target.signing_time > 1"),
+			[]string{"Line 1, column 21: found no matching overload for '_>_'"},
+		},
+		{
+			"santa output type",
+			santaRuleCELExprValidator(),
+			types.StringValue("'BLOCKLIST'"),
+			[]string{"The Santa CEL expression must return a value of type bool or int, not string."},
+		},
+		{
+			"probe action transformation",
+			probeActionCELTransformationValidator(),
+			types.StringValue(`{"serial_number": metadata.machine_serial_number, "user": payload.user.name}`),
+			nil,
+		},
+		{
+			"probe action undeclared reference",
+			probeActionCELTransformationValidator(),
+			types.StringValue(`{"serial_number": event.machine_serial_number}`),
+			[]string{"Line 1, column 19: undeclared reference to 'event'"},
+		},
+		{
+			"probe action multi-line syntax error",
+			probeActionCELTransformationValidator(),
+			types.StringValue("{\n  \"serial_number\": metadata.machine_serial_number,\n  \"user\": payload.user.name,,\n}"),
+			[]string{"Line 3, column 29: Syntax error:"},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("test"), ConfigValue: c.value}
+			resp := &validator.StringResponse{}
+			c.validator.ValidateString(context.Background(), req, resp)
+			if resp.Diagnostics.WarningsCount() > 0 {
+				t.Errorf("unexpected warnings %q", testDiagnosticDetails(resp.Diagnostics.Warnings()))
+			}
+			got := testDiagnosticDetails(resp.Diagnostics.Errors())
+			if len(got) != len(c.errors) {
+				t.Fatalf("expected %d error(s), got %q", len(c.errors), got)
+			}
+			for i, detail := range got {
+				if !strings.HasPrefix(detail, c.errors[i]) {
+					t.Errorf("expected error %q, got %q", c.errors[i], detail)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,214 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+)
+
+const (
+	defaultCredentialsProfile = "default"
+	tokenCommandTimeout       = 1 * time.Minute
+)
+
+// providerCredentials holds the authentication settings found in the
+// provider configuration block.
+type providerCredentials struct {
+	BaseURL         string
+	Token           string
+	TokenCommand    string
+	Profile         string
+	CredentialsFile string
+}
+
+// credentialsProfile is a section of the credentials file.
+type credentialsProfile struct {
+	BaseURL      string `toml:"base_url"`
+	Token        string `toml:"token"`
+	TokenCommand string `toml:"token_command"`
+}
+
+// resolveCredentials returns the base URL and token used to authenticate
+// with the Zentral API. Each of them is taken from the first source where it
+// is set, in this order:
+//
+//  1. the provider configuration block (token, then token_command)
+//  2. the environment variables (ZTL_API_TOKEN, then ZTL_API_TOKEN_COMMAND)
+//  3. the selected credentials file profile (token, then token_command)
+//
+// The profile is selected using the profile attribute or the ZTL_API_PROFILE
+// environment variable. If no profile is selected, the default profile is
+// used if it exists. When a profile is selected, the credentials environment
+// variables are ignored, and the base URL and token must come from the same
+// source, to avoid sending the token of an environment to the URL of another.
+func resolveCredentials(ctx context.Context, pc providerCredentials, getenv func(string) string) (string, string, error) {
+	profile, profileName, err := loadCredentialsProfile(pc, getenv)
+	if err != nil {
+		return "", "", err
+	}
+
+	sources := []credentialsSource{{"provider block", pc.BaseURL, pc.Token, pc.TokenCommand}}
+	if profileName == "" {
+		sources = append(sources, credentialsSource{"environment", getenv("ZTL_API_BASE_URL"), getenv("ZTL_API_TOKEN"), getenv("ZTL_API_TOKEN_COMMAND")})
+	}
+	sources = append(sources, credentialsSource{fmt.Sprintf("profile %q", profileName), profile.BaseURL, profile.Token, profile.TokenCommand})
+
+	var baseURLSrc, tokenSrc *credentialsSource
+	for i := range sources {
+		src := &sources[i]
+		if baseURLSrc == nil && src.baseURL != "" {
+			baseURLSrc = src
+		}
+		if tokenSrc == nil && (src.token != "" || src.tokenCommand != "") {
+			tokenSrc = src
+		}
+	}
+
+	if profileName != "" && baseURLSrc != nil && tokenSrc != nil && baseURLSrc != tokenSrc {
+		return "", "", fmt.Errorf(
+			"the base URL is set in the %s and the token in the %s, they must be set together when the profile %q is selected",
+			baseURLSrc.name, tokenSrc.name, profileName,
+		)
+	}
+
+	var baseURL, token string
+	if baseURLSrc != nil {
+		baseURL = baseURLSrc.baseURL
+	}
+	if tokenSrc != nil {
+		if tokenSrc.token != "" {
+			token = tokenSrc.token
+		} else {
+			token, err = runTokenCommand(ctx, tokenSrc.tokenCommand)
+			if err != nil {
+				return "", "", err
+			}
+		}
+	}
+
+	return baseURL, token, nil
+}
+
+// credentialsSource is one of the places where the credentials can be set.
+type credentialsSource struct {
+	name         string
+	baseURL      string
+	token        string
+	tokenCommand string
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// defaultCredentialsFile returns the path of the credentials file:
+// $XDG_CONFIG_HOME/zentral/credentials or ~/.config/zentral/credentials.
+func defaultCredentialsFile(getenv func(string) string) string {
+	configDir := getenv("XDG_CONFIG_HOME")
+	if configDir == "" {
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			return ""
+		}
+		configDir = filepath.Join(homeDir, ".config")
+	}
+	return filepath.Join(configDir, "zentral", "credentials")
+}
+
+// loadCredentialsProfile returns the selected profile of the credentials file,
+// or an empty profile if no profile is selected and the default one is missing,
+// and the name of the profile if it was explicitly selected.
+func loadCredentialsProfile(pc providerCredentials, getenv func(string) string) (credentialsProfile, string, error) {
+	var profile credentialsProfile
+
+	profileName := firstNonEmpty(pc.Profile, getenv("ZTL_API_PROFILE"))
+	explicitProfile := profileName != ""
+	if !explicitProfile {
+		profileName = defaultCredentialsProfile
+	}
+
+	credentialsFile := firstNonEmpty(pc.CredentialsFile, getenv("ZTL_API_CREDENTIALS_FILE"), defaultCredentialsFile(getenv))
+	f, err := os.Open(credentialsFile)
+	if err != nil {
+		if !explicitProfile && errors.Is(err, os.ErrNotExist) {
+			return profile, "", nil
+		}
+		return profile, "", fmt.Errorf("unable to open the credentials file: %w", err)
+	}
+	defer f.Close()
+
+	profiles, err := parseCredentials(f)
+	if err != nil {
+		return profile, "", fmt.Errorf("unable to parse the credentials file %s: %w", credentialsFile, err)
+	}
+
+	profile, ok := profiles[profileName]
+	if !explicitProfile {
+		return profile, "", nil
+	}
+	if !ok {
+		return profile, "", fmt.Errorf("profile %q not found in the credentials file %s", profileName, credentialsFile)
+	}
+	return profile, profileName, nil
+}
+
+// parseCredentials parses the TOML credentials file, with one table per
+// profile:
+//
+//	[staging]
+//	base_url = "https://zentral-staging.example.com/api/"
+//	token_command = "op read op://zentral/staging/token"
+//
+// The unknown keys are rejected.
+func parseCredentials(r io.Reader) (map[string]credentialsProfile, error) {
+	profiles := make(map[string]credentialsProfile)
+	md, err := toml.NewDecoder(r).Decode(&profiles)
+	if err != nil {
+		return nil, err
+	}
+	if undecoded := md.Undecoded(); len(undecoded) > 0 {
+		return nil, fmt.Errorf("unknown key %q", undecoded[0].String())
+	}
+	return profiles, nil
+}
+
+// runTokenCommand runs the token command using the shell,
+// and returns its trimmed standard output.
+func runTokenCommand(ctx context.Context, command string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, tokenCommandTimeout)
+	defer cancel()
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", command)
+	}
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("token command failed: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	token := strings.TrimSpace(stdout.String())
+	if token == "" {
+		return "", errors.New("token command returned an empty token")
+	}
+	return token, nil
+}
@@ -0,0 +1,283 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testCredentials = `
+# Zentral credentials
+[default]
+base_url = "https://zentral.example.com/api/"
+token = "default-token"
+
+[staging]
+base_url = 'https://zentral-staging.example.com/api/'
+token_command = "echo staging-token" # from the password manager in production
+
+# no base URL
+[partial]
+token = "partial\u002dtoken"
+`
+
+func testCredentialsFile(t *testing.T, content string) string {
+	p := filepath.Join(t.TempDir(), "credentials")
+	if err := os.WriteFile(p, []byte(content), 0600); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	return p
+}
+
+func testGetenv(env map[string]string) func(string) string {
+	return func(k string) string {
+		return env[k]
+	}
+}
+
+func TestResolveCredentialsPrecedence(t *testing.T) {
+	credentialsFile := testCredentialsFile(t, testCredentials)
+	for _, tc := range []struct {
+		name            string
+		pc              providerCredentials
+		env             map[string]string
+		expectedBaseURL string
+		expectedToken   string
+	}{
+		{
+			name:            "default profile",
+			pc:              providerCredentials{},
+			expectedBaseURL: "https://zentral.example.com/api/",
+			expectedToken:   "default-token",
+		},
+		{
+			name:            "selected profile with token command",
+			pc:              providerCredentials{Profile: "staging"},
+			expectedBaseURL: "https://zentral-staging.example.com/api/",
+			expectedToken:   "staging-token",
+		},
+		{
+			name:            "profile selected with the environment",
+			env:             map[string]string{"ZTL_API_PROFILE": "staging"},
+			expectedBaseURL: "https://zentral-staging.example.com/api/",
+			expectedToken:   "staging-token",
+		},
+		{
+			name:            "profile attribute over profile environment variable",
+			pc:              providerCredentials{Profile: "partial"},
+			env:             map[string]string{"ZTL_API_PROFILE": "staging"},
+			expectedBaseURL: "",
+			expectedToken:   "partial-token",
+		},
+		{
+			name: "environment over default profile",
+			env: map[string]string{
+				"ZTL_API_BASE_URL": "https://zentral-env.example.com/api/",
+				"ZTL_API_TOKEN":    "env-token",
+			},
+			expectedBaseURL: "https://zentral-env.example.com/api/",
+			expectedToken:   "env-token",
+		},
+		{
+			name: "environment ignored with selected profile",
+			pc:   providerCredentials{Profile: "staging"},
+			env: map[string]string{
+				"ZTL_API_BASE_URL":      "https://zentral-env.example.com/api/",
+				"ZTL_API_TOKEN":         "env-token",
+				"ZTL_API_TOKEN_COMMAND": "echo env-command-token",
+			},
+			expectedBaseURL: "https://zentral-staging.example.com/api/",
+			expectedToken:   "staging-token",
+		},
+		{
+			name:            "environment token command over default profile",
+			env:             map[string]string{"ZTL_API_TOKEN_COMMAND": "echo env-command-token"},
+			expectedBaseURL: "https://zentral.example.com/api/",
+			expectedToken:   "env-command-token",
+		},
+		{
+			name: "provider block over selected profile",
+			pc: providerCredentials{
+				BaseURL: "https://zentral-block.example.com/api/",
+				Token:   "block-token",
+				Profile: "staging",
+			},
+			expectedBaseURL: "https://zentral-block.example.com/api/",
+			expectedToken:   "block-token",
+		},
+		{
+			name: "environment token over environment token command",
+			env: map[string]string{
+				"ZTL_API_TOKEN":         "env-token",
+				"ZTL_API_TOKEN_COMMAND": "echo env-command-token",
+			},
+			expectedBaseURL: "https://zentral.example.com/api/",
+			expectedToken:   "env-token",
+		},
+		{
+			name: "provider block over environment",
+			pc: providerCredentials{
+				BaseURL: "https://zentral-block.example.com/api/",
+				Token:   "block-token",
+			},
+			env: map[string]string{
+				"ZTL_API_BASE_URL": "https://zentral-env.example.com/api/",
+				"ZTL_API_TOKEN":    "env-token",
+			},
+			expectedBaseURL: "https://zentral-block.example.com/api/",
+			expectedToken:   "block-token",
+		},
+		{
+			name: "provider block token command over environment token",
+			pc:   providerCredentials{TokenCommand: "printf '  block-command-token\n'"},
+			env: map[string]string{
+				"ZTL_API_TOKEN": "env-token",
+			},
+			expectedBaseURL: "https://zentral.example.com/api/",
+			expectedToken:   "block-command-token",
+		},
+		{
+			name: "provider block token over provider block token command",
+			pc: providerCredentials{
+				Token:        "block-token",
+				TokenCommand: "exit 1",
+			},
+			expectedBaseURL: "https://zentral.example.com/api/",
+			expectedToken:   "block-token",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.pc.CredentialsFile = credentialsFile
+			baseURL, token, err := resolveCredentials(context.Background(), tc.pc, testGetenv(tc.env))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if baseURL != tc.expectedBaseURL {
+				t.Errorf("expected base URL %q, got %q", tc.expectedBaseURL, baseURL)
+			}
+			if token != tc.expectedToken {
+				t.Errorf("expected token %q, got %q", tc.expectedToken, token)
+			}
+		})
+	}
+}
+
+func TestResolveCredentialsMissingFile(t *testing.T) {
+	missingFile := filepath.Join(t.TempDir(), "credentials")
+
+	// no profile selected → environment only
+	baseURL, token, err := resolveCredentials(
+		context.Background(),
+		providerCredentials{CredentialsFile: missingFile},
+		testGetenv(map[string]string{"ZTL_API_TOKEN": "env-token"}),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if baseURL != "" || token != "env-token" {
+		t.Errorf("unexpected credentials %q %q", baseURL, token)
+	}
+
+	// profile selected → error
+	_, _, err = resolveCredentials(
+		context.Background(),
+		providerCredentials{CredentialsFile: missingFile, Profile: "staging"},
+		testGetenv(nil),
+	)
+	if err == nil {
+		t.Error("expected an error")
+	}
+}
+
+func TestResolveCredentialsMixedSources(t *testing.T) {
+	credentialsFile := testCredentialsFile(t, testCredentials)
+	for _, tc := range []struct {
+		name string
+		pc   providerCredentials
+		env  map[string]string
+		err  string
+	}{
+		{
+			name: "provider block token with profile base URL",
+			pc:   providerCredentials{Token: "block-token", Profile: "staging"},
+			err:  `the base URL is set in the profile "staging" and the token in the provider block`,
+		},
+		{
+			name: "provider block base URL with profile token command",
+			pc:   providerCredentials{BaseURL: "https://zentral-block.example.com/api/"},
+			env:  map[string]string{"ZTL_API_PROFILE": "staging"},
+			err:  `the base URL is set in the provider block and the token in the profile "staging"`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.pc.CredentialsFile = credentialsFile
+			_, _, err := resolveCredentials(context.Background(), tc.pc, testGetenv(tc.env))
+			if err == nil || !strings.HasPrefix(err.Error(), tc.err) {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestResolveCredentialsUnknownProfile(t *testing.T) {
+	_, _, err := resolveCredentials(
+		context.Background(),
+		providerCredentials{CredentialsFile: testCredentialsFile(t, testCredentials), Profile: "yolo"},
+		testGetenv(nil),
+	)
+	if err == nil || !strings.Contains(err.Error(), `profile "yolo" not found`) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestResolveCredentialsDefaultFileLocation(t *testing.T) {
+	configDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(configDir, "zentral"), 0700); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := os.WriteFile(filepath.Join(configDir, "zentral", "credentials"), []byte(testCredentials), 0600); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	_, token, err := resolveCredentials(
+		context.Background(),
+		providerCredentials{},
+		testGetenv(map[string]string{"XDG_CONFIG_HOME": configDir}),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if token != "default-token" {
+		t.Errorf("unexpected token %q", token)
+	}
+}
+
+func TestResolveCredentialsFailingTokenCommand(t *testing.T) {
+	for _, command := range []string{"echo yolo >&2; exit 1", "true"} {
+		_, _, err := resolveCredentials(
+			context.Background(),
+			providerCredentials{CredentialsFile: filepath.Join(t.TempDir(), "credentials"), TokenCommand: command},
+			testGetenv(nil),
+		)
+		if err == nil {
+			t.Errorf("%q: expected an error", command)
+		}
+	}
+}
+
+func TestParseCredentialsErrors(t *testing.T) {
+	for _, content := range []string{
+		"token = outside",
+		"[default\ntoken = yolo",
+		"[]",
+		"[default]\ntoken",
+		"[default]\ntoken = yolo",
+		"[default]\npassword = \"yolo\"",
+		"[default]\ntoken = 1",
+	} {
+		if _, err := parseCredentials(strings.NewReader(content)); err == nil {
+			t.Errorf("%q: expected an error", content)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testAccEchoProvider is a test provider used to verify the values of the
+// ephemeral resources. Its data configuration attribute accepts ephemeral
+// values, and is copied to the data attribute of the echo resources, where
+// it can be checked like any other resource attribute.
+type testAccEchoProvider struct{}
+
+var _ provider.Provider = &testAccEchoProvider{}
+
+func newTestAccEchoProvider() provider.Provider {
+	return &testAccEchoProvider{}
+}
+
+func (p *testAccEchoProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "echo"
+}
+
+func (p *testAccEchoProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
+	resp.Schema = providerschema.Schema{
+		Attributes: map[string]providerschema.Attribute{
+			"data": providerschema.DynamicAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (p *testAccEchoProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	var data types.Dynamic
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("data"), &data)...)
+	resp.ResourceData = data
+}
+
+func (p *testAccEchoProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		func() resource.Resource { return &testAccEchoResource{} },
+	}
+}
+
+func (p *testAccEchoProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+// testAccEchoResource stores the data of its provider configuration.
+type testAccEchoResource struct {
+	data types.Dynamic
+}
+
+var _ resource.ResourceWithConfigure = &testAccEchoResource{}
+
+func (r *testAccEchoResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName
+}
+
+func (r *testAccEchoResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"data": schema.DynamicAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *testAccEchoResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if data, ok := req.ProviderData.(types.Dynamic); ok {
+		r.data = data
+	}
+}
+
+func (r *testAccEchoResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("data"), r.data)...)
+}
+
+func (r *testAccEchoResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("data"), r.data)...)
+}
+
+func (r *testAccEchoResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("data"), r.data)...)
+}
+
+func (r *testAccEchoResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
@@ -0,0 +1,240 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/zentralopensource/goztl"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ ephemeral.EphemeralResource = &EnrollmentEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &EnrollmentEphemeralResource{}
+
+// enrollmentSecretAndURLs is the ephemeral data of an enrollment.
+type enrollmentSecretAndURLs struct {
+	Secret string
+	URLs   map[string]string
+}
+
+// enrollmentURLAttribute describes an enrollment URL attribute.
+type enrollmentURLAttribute struct {
+	Name        string
+	Description string
+}
+
+// EnrollmentEphemeralResource defines the ephemeral resource implementation
+// shared by the enrollments, to fetch their secret without persisting it.
+type EnrollmentEphemeralResource struct {
+	client *goztl.Client
+
+	typeNameSuffix string
+	name           string
+	urlAttributes  []enrollmentURLAttribute
+	get            func(ctx context.Context, client *goztl.Client, id int) (*enrollmentSecretAndURLs, error)
+}
+
+func NewSantaEnrollmentEphemeralResource() ephemeral.EphemeralResource {
+	return &EnrollmentEphemeralResource{
+		typeNameSuffix: "_santa_enrollment",
+		name:           "Santa enrollment",
+		urlAttributes: []enrollmentURLAttribute{
+			{"configuration_profile_url", "Santa configuration profile download URL"},
+			{"plist_url", "Santa plist download URL"},
+		},
+		get: func(ctx context.Context, client *goztl.Client, id int) (*enrollmentSecretAndURLs, error) {
+			ztlSE, _, err := client.SantaEnrollments.GetByID(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			return &enrollmentSecretAndURLs{
+				Secret: ztlSE.Secret.Secret,
+				URLs: map[string]string{
+					"configuration_profile_url": ztlSE.ConfigProfileURL,
+					"plist_url":                 ztlSE.PlistURL,
+				},
+			}, nil
+		},
+	}
+}
+
+func NewOsqueryEnrollmentEphemeralResource() ephemeral.EphemeralResource {
+	return &EnrollmentEphemeralResource{
+		typeNameSuffix: "_osquery_enrollment",
+		name:           "Osquery enrollment",
+		urlAttributes: []enrollmentURLAttribute{
+			{"package_url", "macOS package download URL"},
+			{"script_url", "Linux script download URL"},
+			{"powershell_script_url", "Powershell script download URL"},
+		},
+		get: func(ctx context.Context, client *goztl.Client, id int) (*enrollmentSecretAndURLs, error) {
+			ztlOE, _, err := client.OsqueryEnrollments.GetByID(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			return &enrollmentSecretAndURLs{
+				Secret: ztlOE.Secret.Secret,
+				URLs: map[string]string{
+					"package_url":           ztlOE.PackageURL,
+					"script_url":            ztlOE.ScriptURL,
+					"powershell_script_url": ztlOE.PowershellScriptURL,
+				},
+			}, nil
+		},
+	}
+}
+
+func NewMunkiEnrollmentEphemeralResource() ephemeral.EphemeralResource {
+	return &EnrollmentEphemeralResource{
+		typeNameSuffix: "_munki_enrollment",
+		name:           "Munki enrollment",
+		urlAttributes: []enrollmentURLAttribute{
+			{"package_url", "Package download URL"},
+		},
+		get: func(ctx context.Context, client *goztl.Client, id int) (*enrollmentSecretAndURLs, error) {
+			ztlME, _, err := client.MunkiEnrollments.GetByID(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			return &enrollmentSecretAndURLs{
+				Secret: ztlME.Secret.Secret,
+				URLs: map[string]string{
+					"package_url": ztlME.PackageURL,
+				},
+			}, nil
+		},
+	}
+}
+
+func NewMonolithEnrollmentEphemeralResource() ephemeral.EphemeralResource {
+	return &EnrollmentEphemeralResource{
+		typeNameSuffix: "_monolith_enrollment",
+		name:           "Monolith enrollment",
+		urlAttributes: []enrollmentURLAttribute{
+			{"configuration_profile_url", "Monolith configuration profile download URL"},
+			{"plist_url", "Monolith plist download URL"},
+		},
+		get: func(ctx context.Context, client *goztl.Client, id int) (*enrollmentSecretAndURLs, error) {
+			ztlME, _, err := client.MonolithEnrollments.GetByID(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			return &enrollmentSecretAndURLs{
+				Secret: ztlME.Secret.Secret,
+				URLs: map[string]string{
+					"configuration_profile_url": ztlME.ConfigProfileURL,
+					"plist_url":                 ztlME.PlistURL,
+				},
+			}, nil
+		},
+	}
+}
+
+func NewMDMOTAEnrollmentEphemeralResource() ephemeral.EphemeralResource {
+	return &EnrollmentEphemeralResource{
+		typeNameSuffix: "_mdm_ota_enrollment",
+		name:           "MDM OTA enrollment",
+		get: func(ctx context.Context, client *goztl.Client, id int) (*enrollmentSecretAndURLs, error) {
+			ztlMOE, _, err := client.MDMOTAEnrollments.GetByID(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			return &enrollmentSecretAndURLs{
+				Secret: ztlMOE.Secret.Secret,
+			}, nil
+		},
+	}
+}
+
+func (r *EnrollmentEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.typeNameSuffix
+}
+
+func (r *EnrollmentEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Description:         fmt.Sprintf("ID of the %s.", r.name),
+			MarkdownDescription: fmt.Sprintf("`ID` of the %s.", r.name),
+			Required:            true,
+		},
+		"secret": schema.StringAttribute{
+			Description:         "Enrollment secret.",
+			MarkdownDescription: "Enrollment secret.",
+			Computed:            true,
+			Sensitive:           true,
+		},
+	}
+	for _, urlAttr := range r.urlAttributes {
+		attributes[urlAttr.Name] = schema.StringAttribute{
+			Description:         urlAttr.Description + ".",
+			MarkdownDescription: urlAttr.Description + ".",
+			Computed:            true,
+		}
+	}
+	retrieved := fmt.Sprintf("the %s secret", r.name)
+	if len(r.urlAttributes) > 0 {
+		retrieved = fmt.Sprintf("the %s secret and URLs", r.name)
+	}
+	resp.Schema = schema.Schema{
+		Description: fmt.Sprintf("Allows %s to be retrieved without being persisted in the state.", retrieved),
+		MarkdownDescription: fmt.Sprintf(
+			"The ephemeral resource `zentral%s` allows %s to be retrieved without being persisted in the state.",
+			r.typeNameSuffix, retrieved,
+		),
+		Attributes: attributes,
+	}
+}
+
+func (r *EnrollmentEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*goztl.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *goztl.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *EnrollmentEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var id types.Int64
+
+	// Read Terraform configuration data
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("id"), &id)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	esu, err := r.get(ctx, r.client, int(id.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to get %s %d, got error: %s", r.name, id.ValueInt64(), err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("opened a %s ephemeral resource", r.name))
+
+	// Save data into the ephemeral result
+	resp.Diagnostics.Append(resp.Result.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.Result.SetAttribute(ctx, path.Root("secret"), types.StringValue(esu.Secret))...)
+	for _, urlAttr := range r.urlAttributes {
+		resp.Diagnostics.Append(resp.Result.SetAttribute(ctx, path.Root(urlAttr.Name), types.StringValue(esu.URLs[urlAttr.Name]))...)
+	}
+}
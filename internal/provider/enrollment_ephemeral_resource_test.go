@@ -0,0 +1,217 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccEnrollmentEphemeralResources(t *testing.T) {
+	name := acctest.RandString(12)
+	seResourceName := "zentral_santa_enrollment.test"
+	oeResourceName := "zentral_osquery_enrollment.test"
+	meResourceName := "zentral_munki_enrollment.test"
+	moeResourceName := "zentral_monolith_enrollment.test"
+	mdmoeResourceName := "zentral_mdm_ota_enrollment.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"zentral": testAccProtoV6ProviderFactories["zentral"],
+			"echo":    providerserver.NewProtocol6WithError(newTestAccEchoProvider()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnrollmentEphemeralResourcesConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Santa
+					resource.TestCheckResourceAttrPair(
+						"echo.santa", "data.id", seResourceName, "id"),
+					resource.TestCheckResourceAttrPair(
+						"echo.santa", "data.secret", seResourceName, "secret"),
+					resource.TestCheckResourceAttrPair(
+						"echo.santa", "data.configuration_profile_url", seResourceName, "configuration_profile_url"),
+					resource.TestCheckResourceAttrPair(
+						"echo.santa", "data.plist_url", seResourceName, "plist_url"),
+					// Osquery
+					resource.TestCheckResourceAttrPair(
+						"echo.osquery", "data.id", oeResourceName, "id"),
+					resource.TestCheckResourceAttrPair(
+						"echo.osquery", "data.secret", oeResourceName, "secret"),
+					resource.TestCheckResourceAttrPair(
+						"echo.osquery", "data.package_url", oeResourceName, "package_url"),
+					resource.TestCheckResourceAttrPair(
+						"echo.osquery", "data.script_url", oeResourceName, "script_url"),
+					resource.TestCheckResourceAttrPair(
+						"echo.osquery", "data.powershell_script_url", oeResourceName, "powershell_script_url"),
+					// Munki
+					resource.TestCheckResourceAttrPair(
+						"echo.munki", "data.id", meResourceName, "id"),
+					resource.TestCheckResourceAttrPair(
+						"echo.munki", "data.secret", meResourceName, "secret"),
+					resource.TestCheckResourceAttrPair(
+						"echo.munki", "data.package_url", meResourceName, "package_url"),
+					// Monolith
+					resource.TestCheckResourceAttrPair(
+						"echo.monolith", "data.id", moeResourceName, "id"),
+					resource.TestCheckResourceAttrPair(
+						"echo.monolith", "data.secret", moeResourceName, "secret"),
+					resource.TestCheckResourceAttrPair(
+						"echo.monolith", "data.configuration_profile_url", moeResourceName, "configuration_profile_url"),
+					resource.TestCheckResourceAttrPair(
+						"echo.monolith", "data.plist_url", moeResourceName, "plist_url"),
+					// MDM OTA
+					resource.TestCheckResourceAttrPair(
+						"echo.mdm_ota", "data.id", mdmoeResourceName, "id"),
+					resource.TestCheckResourceAttrPair(
+						"echo.mdm_ota", "data.secret", mdmoeResourceName, "secret"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEnrollmentEphemeralResourcesConfig(name string) string {
+	return fmt.Sprintf(`
+resource "zentral_meta_business_unit" "test" {
+  name = %[1]q
+}
+
+# Santa
+
+resource "zentral_santa_configuration" "test" {
+  name = %[1]q
+}
+
+resource "zentral_santa_enrollment" "test" {
+  configuration_id      = zentral_santa_configuration.test.id
+  meta_business_unit_id = zentral_meta_business_unit.test.id
+}
+
+ephemeral "zentral_santa_enrollment" "test" {
+  id = zentral_santa_enrollment.test.id
+}
+
+provider "echo" {
+  alias = "santa"
+  data  = ephemeral.zentral_santa_enrollment.test
+}
+
+resource "echo" "santa" {
+  provider = echo.santa
+}
+
+# Osquery
+
+resource "zentral_osquery_configuration" "test" {
+  name = %[1]q
+}
+
+resource "zentral_osquery_enrollment" "test" {
+  configuration_id      = zentral_osquery_configuration.test.id
+  meta_business_unit_id = zentral_meta_business_unit.test.id
+}
+
+ephemeral "zentral_osquery_enrollment" "test" {
+  id = zentral_osquery_enrollment.test.id
+}
+
+provider "echo" {
+  alias = "osquery"
+  data  = ephemeral.zentral_osquery_enrollment.test
+}
+
+resource "echo" "osquery" {
+  provider = echo.osquery
+}
+
+# Munki
+
+resource "zentral_munki_configuration" "test" {
+  name = %[1]q
+}
+
+resource "zentral_munki_enrollment" "test" {
+  configuration_id      = zentral_munki_configuration.test.id
+  meta_business_unit_id = zentral_meta_business_unit.test.id
+}
+
+ephemeral "zentral_munki_enrollment" "test" {
+  id = zentral_munki_enrollment.test.id
+}
+
+provider "echo" {
+  alias = "munki"
+  data  = ephemeral.zentral_munki_enrollment.test
+}
+
+resource "echo" "munki" {
+  provider = echo.munki
+}
+
+# Monolith
+
+resource "zentral_monolith_manifest" "test" {
+  name                  = %[1]q
+  meta_business_unit_id = zentral_meta_business_unit.test.id
+}
+
+resource "zentral_monolith_enrollment" "test" {
+  manifest_id           = zentral_monolith_manifest.test.id
+  meta_business_unit_id = zentral_meta_business_unit.test.id
+}
+
+ephemeral "zentral_monolith_enrollment" "test" {
+  id = zentral_monolith_enrollment.test.id
+}
+
+provider "echo" {
+  alias = "monolith"
+  data  = ephemeral.zentral_monolith_enrollment.test
+}
+
+resource "echo" "monolith" {
+  provider = echo.monolith
+}
+
+# MDM OTA
+
+# provisioned resource on the integration server
+data "zentral_mdm_push_certificate" "test" {
+  name = "TF provider GitHub"
+}
+
+resource "zentral_mdm_scep_issuer" "test" {
+  name = %[1]q
+  url = "https://www.example.com/scep"
+  backend = "STATIC_CHALLENGE"
+  static_challenge = {
+    challenge = "yolo"
+  }
+}
+
+resource "zentral_mdm_ota_enrollment" "test" {
+  name                  = %[1]q
+  push_certificate_id   = data.zentral_mdm_push_certificate.test.id
+  scep_issuer_id        = zentral_mdm_scep_issuer.test.id
+  meta_business_unit_id = zentral_meta_business_unit.test.id
+}
+
+ephemeral "zentral_mdm_ota_enrollment" "test" {
+  id = zentral_mdm_ota_enrollment.test.id
+}
+
+provider "echo" {
+  alias = "mdm_ota"
+  data  = ephemeral.zentral_mdm_ota_enrollment.test
+}
+
+resource "echo" "mdm_ota" {
+  provider = echo.mdm_ota
+}
+`, name)
+}
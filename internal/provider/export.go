@@ -0,0 +1,184 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/zentralopensource/goztl"
+)
+
+// Export writes the Terraform configuration of the existing Zentral objects in
+// the directory, with the import blocks required to manage them. The API
+// credentials are read from the environment variables or the credentials file,
+// like in the provider configuration.
+func Export(ctx context.Context, version string, dir string) error {
+	baseURL, token, err := resolveCredentials(ctx, providerCredentials{}, os.Getenv)
+	if err != nil {
+		return err
+	}
+	if baseURL == "" {
+		return errors.New("missing Zentral API base URL, set the ZTL_API_BASE_URL environment variable")
+	}
+	if token == "" {
+		return errors.New("missing Zentral API token, set the ZTL_API_TOKEN environment variable")
+	}
+
+	httpClient, err := newHTTPClient(ctx, defaultHTTPClientConfig())
+	if err != nil {
+		return fmt.Errorf("unable to configure the HTTP client: %w", err)
+	}
+
+	userAgent := fmt.Sprintf("terraform-provider-zentral/%s", version)
+	c, err := goztl.NewClient(httpClient, baseURL, token, goztl.SetUserAgent(userAgent))
+	if err != nil {
+		return fmt.Errorf("unable to create Zentral client: %w", err)
+	}
+
+	return newExporter().export(ctx, dir, exportResources(c))
+}
+
+// exportObjects converts the API objects to export objects.
+func exportObjects[T any](objs []T, f func(*T) exportObject) []exportObject {
+	eos := make([]exportObject, 0, len(objs))
+	for i := range objs {
+		eos = append(eos, f(&objs[i]))
+	}
+	return eos
+}
+
+// exportResources returns the exported resources. The referenced resources
+// must be exported first.
+func exportResources(c *goztl.Client) []exportResource {
+	return []exportResource{
+		{
+			TypeName:    "zentral_taxonomy",
+			NewResource: NewTaxonomyResource,
+			List: func(ctx context.Context, e *exporter) ([]exportObject, error) {
+				objs, err := listAllPages(ctx, c.Taxonomies.List)
+				return exportObjects(objs, func(t *goztl.Taxonomy) exportObject {
+					return exportObject{strconv.Itoa(t.ID), t.Name, taxonomyForState(t)}
+				}), err
+			},
+		},
+		{
+			TypeName:    "zentral_tag",
+			NewResource: NewTagResource,
+			References:  map[string]string{"taxonomy_id": "zentral_taxonomy"},
+			List: func(ctx context.Context, e *exporter) ([]exportObject, error) {
+				objs, err := listAllPages(ctx, c.Tags.List)
+				return exportObjects(objs, func(t *goztl.Tag) exportObject {
+					return exportObject{strconv.Itoa(t.ID), t.Name, tagForState(t)}
+				}), err
+			},
+		},
+		{
+			TypeName:    "zentral_meta_business_unit",
+			NewResource: NewMetaBusinessUnitResource,
+			List: func(ctx context.Context, e *exporter) ([]exportObject, error) {
+				objs, err := listAllPages(ctx, c.MetaBusinessUnits.List)
+				return exportObjects(objs, func(mbu *goztl.MetaBusinessUnit) exportObject {
+					return exportObject{strconv.Itoa(mbu.ID), mbu.Name, metaBusinessUnitForState(mbu)}
+				}), err
+			},
+		},
+		{
+			TypeName:    "zentral_santa_configuration",
+			NewResource: NewSantaConfigurationResource,
+			List: func(ctx context.Context, e *exporter) ([]exportObject, error) {
+				objs, err := listAllPages(ctx, c.SantaConfigurations.List)
+				return exportObjects(objs, func(sc *goztl.SantaConfiguration) exportObject {
+					return exportObject{strconv.Itoa(sc.ID), sc.Name, santaConfigurationForState(sc)}
+				}), err
+			},
+		},
+		{
+			TypeName:    "zentral_osquery_configuration",
+			NewResource: NewOsqueryConfigurationResource,
+			List: func(ctx context.Context, e *exporter) ([]exportObject, error) {
+				objs, err := listAllPages(ctx, c.OsqueryConfigurations.List)
+				return exportObjects(objs, func(oc *goztl.OsqueryConfiguration) exportObject {
+					return exportObject{strconv.Itoa(oc.ID), oc.Name, osqueryConfigurationForState(oc)}
+				}), err
+			},
+		},
+		{
+			TypeName:    "zentral_munki_configuration",
+			NewResource: NewMunkiConfigurationResource,
+			List: func(ctx context.Context, e *exporter) ([]exportObject, error) {
+				objs, err := listAllPages(ctx, c.MunkiConfigurations.List)
+				return exportObjects(objs, func(mc *goztl.MunkiConfiguration) exportObject {
+					return exportObject{strconv.Itoa(mc.ID), mc.Name, munkiConfigurationForState(mc)}
+				}), err
+			},
+		},
+		{
+			TypeName:    "zentral_probe",
+			NewResource: NewProbeResource,
+			References: map[string]string{
+				"meta_business_unit_ids": "zentral_meta_business_unit",
+				"tag_ids":                "zentral_tag",
+			},
+			List: func(ctx context.Context, e *exporter) ([]exportObject, error) {
+				objs, err := listAllPages(ctx, c.Probes.List)
+				return exportObjects(objs, func(p *goztl.Probe) exportObject {
+					return exportObject{strconv.Itoa(p.ID), p.Name, probeForState(p)}
+				}), err
+			},
+		},
+		{
+			TypeName:    "zentral_store",
+			NewResource: NewStoreResource,
+			List: func(ctx context.Context, e *exporter) ([]exportObject, error) {
+				objs, err := listAllPages(ctx, c.Stores.List)
+				return exportObjects(objs, func(s *goztl.Store) exportObject {
+					return exportObject{s.ID, s.Name, storeForState(s)}
+				}), err
+			},
+		},
+		{
+			TypeName:    "zentral_mdm_blueprint",
+			NewResource: NewMDMBlueprintResource,
+			List: func(ctx context.Context, e *exporter) ([]exportObject, error) {
+				objs, err := listAllPages(ctx, c.MDMBlueprints.List)
+				return exportObjects(objs, func(mb *goztl.MDMBlueprint) exportObject {
+					return exportObject{strconv.Itoa(mb.ID), mb.Name, mdmBlueprintForState(mb)}
+				}), err
+			},
+		},
+		{
+			TypeName:    "zentral_mdm_artifact",
+			NewResource: NewMDMArtifactResource,
+			References:  map[string]string{"requires": "zentral_mdm_artifact"},
+			List: func(ctx context.Context, e *exporter) ([]exportObject, error) {
+				objs, err := listAllPages(ctx, c.MDMArtifacts.List)
+				return exportObjects(objs, func(ma *goztl.MDMArtifact) exportObject {
+					return exportObject{ma.ID, ma.Name, mdmArtifactForState(ma)}
+				}), err
+			},
+		},
+		{
+			TypeName:    "zentral_mdm_blueprint_artifact",
+			NewResource: NewMDMBlueprintArtifactResource,
+			References: map[string]string{
+				"blueprint_id":     "zentral_mdm_blueprint",
+				"artifact_id":      "zentral_mdm_artifact",
+				"excluded_tag_ids": "zentral_tag",
+				"tag_id":           "zentral_tag",
+			},
+			List: func(ctx context.Context, e *exporter) ([]exportObject, error) {
+				objs, err := listAllPages(ctx, c.MDMBlueprintArtifacts.List)
+				return exportObjects(objs, func(mba *goztl.MDMBlueprintArtifact) exportObject {
+					name := fmt.Sprintf(
+						"%s %s",
+						e.Label("zentral_mdm_blueprint", strconv.Itoa(mba.BlueprintID)),
+						e.Label("zentral_mdm_artifact", mba.ArtifactID),
+					)
+					return exportObject{strconv.Itoa(mba.ID), name, mdmBlueprintArtifactForState(mba)}
+				}), err
+			},
+		},
+	}
+}
@@ -0,0 +1,395 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const exportFileHeader = "# Generated by terraform-provider-zentral -export\n\n"
+
+// exportObject is an existing Zentral object to export.
+type exportObject struct {
+	// ID used in the import block.
+	ID string
+	// Name used to build the resource label.
+	Name string
+	// Model is the resource model, with the tfsdk struct tags.
+	Model interface{}
+}
+
+// exportResource describes how the objects of a resource type are exported.
+type exportResource struct {
+	TypeName    string
+	NewResource func() resource.Resource
+	// References maps the names of the attributes holding object IDs, at any
+	// level, to the type names of the referenced resources.
+	References map[string]string
+	// List returns the objects to export. The exporter can be used to get the
+	// labels of the objects of the resource types exported before.
+	List func(ctx context.Context, e *exporter) ([]exportObject, error)
+}
+
+// exporter writes the Terraform configuration of the existing Zentral objects,
+// with the import blocks required to bring them under Terraform management.
+// The IDs of the exported objects are replaced by references to their resource
+// addresses, and the sensitive values by references to input variables.
+type exporter struct {
+	labels     map[string]map[string]string
+	usedLabels map[string]map[string]bool
+	variables  map[string]bool
+}
+
+func newExporter() *exporter {
+	return &exporter{
+		labels:     make(map[string]map[string]string),
+		usedLabels: make(map[string]map[string]bool),
+		variables:  make(map[string]bool),
+	}
+}
+
+// Label returns the label of an exported object,
+// or an empty string if the object was not exported.
+func (e *exporter) Label(typeName string, id string) string {
+	return e.labels[typeName][id]
+}
+
+// export lists the objects of the resources, in order, and writes a
+// configuration file per resource type, and a file with the variables of the
+// sensitive values, in the directory. Existing files are never overwritten.
+func (e *exporter) export(ctx context.Context, dir string, resources []exportResource) error {
+	files := make(map[string][]byte)
+	var fileNames []string
+	for _, r := range resources {
+		objs, err := r.List(ctx, e)
+		if err != nil {
+			return fmt.Errorf("unable to list the %s objects: %w", r.TypeName, err)
+		}
+		if len(objs) == 0 {
+			continue
+		}
+		fileName := strings.TrimPrefix(r.TypeName, "zentral_") + ".tf"
+		files[fileName] = e.resourceFile(ctx, r, objs)
+		fileNames = append(fileNames, fileName)
+	}
+	if len(e.variables) > 0 {
+		files["variables.tf"] = e.variablesFile()
+		fileNames = append(fileNames, "variables.tf")
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	for _, fileName := range fileNames {
+		p := filepath.Join(dir, fileName)
+		if _, err := os.Stat(p); err == nil {
+			return fmt.Errorf("file %s already exists", p)
+		} else if !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+	for _, fileName := range fileNames {
+		if err := writeNewFile(filepath.Join(dir, fileName), files[fileName]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeNewFile(p string, b []byte) error {
+	f, err := os.OpenFile(p, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// resourceFile returns the import and resource blocks of the objects.
+func (e *exporter) resourceFile(ctx context.Context, r exportResource, objs []exportObject) []byte {
+	// register all the labels first, for the references between objects of the same type
+	labels := make([]string, len(objs))
+	for i, obj := range objs {
+		labels[i] = e.addLabel(r.TypeName, obj.ID, obj.Name)
+	}
+
+	var schemaResp resource.SchemaResponse
+	r.NewResource().Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	f := hclwrite.NewEmptyFile()
+	body := f.Body()
+	for i, obj := range objs {
+		if i > 0 {
+			body.AppendNewline()
+		}
+		importBody := body.AppendNewBlock("import", nil).Body()
+		importBody.SetAttributeTraversal("to", hcl.Traversal{
+			hcl.TraverseRoot{Name: r.TypeName},
+			hcl.TraverseAttr{Name: labels[i]},
+		})
+		importBody.SetAttributeValue("id", cty.StringVal(obj.ID))
+		body.AppendNewline()
+
+		resourceBody := body.AppendNewBlock("resource", []string{r.TypeName, labels[i]}).Body()
+		values := modelAttributes(obj.Model)
+		varPrefix := strings.TrimPrefix(r.TypeName, "zentral_") + "_" + labels[i]
+		for _, name := range sortedAttributeNames(values) {
+			a := schemaResp.Schema.Attributes[name]
+			if a == nil || isComputedOnly(a) {
+				continue
+			}
+			if toks := e.valueTokens(r.References, name, a, varPrefix+"_"+name, values[name]); toks != nil {
+				resourceBody.SetAttributeRaw(name, toks)
+			}
+		}
+	}
+	return append([]byte(exportFileHeader), f.Bytes()...)
+}
+
+func (e *exporter) variablesFile() []byte {
+	names := make([]string, 0, len(e.variables))
+	for name := range e.variables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	f := hclwrite.NewEmptyFile()
+	body := f.Body()
+	for i, name := range names {
+		if i > 0 {
+			body.AppendNewline()
+		}
+		body.AppendNewBlock("variable", []string{name}).Body().SetAttributeValue("sensitive", cty.True)
+	}
+	return append([]byte(exportFileHeader), f.Bytes()...)
+}
+
+// addLabel registers and returns a unique resource label built from the name.
+func (e *exporter) addLabel(typeName string, id string, name string) string {
+	if e.labels[typeName] == nil {
+		e.labels[typeName] = make(map[string]string)
+		e.usedLabels[typeName] = make(map[string]bool)
+	}
+	base := exportLabel(name)
+	label := base
+	for i := 2; e.usedLabels[typeName][label]; i++ {
+		label = fmt.Sprintf("%s_%d", base, i)
+	}
+	e.usedLabels[typeName][label] = true
+	e.labels[typeName][id] = label
+	return label
+}
+
+// exportLabel returns a valid Terraform identifier built from a name.
+func exportLabel(name string) string {
+	var b strings.Builder
+	underscore := false
+	for _, r := range strings.ToLower(name) {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			b.WriteRune(r)
+			underscore = false
+		} else if !underscore && b.Len() > 0 {
+			b.WriteRune('_')
+			underscore = true
+		}
+	}
+	label := strings.TrimSuffix(b.String(), "_")
+	if label == "" {
+		return "object"
+	}
+	if unicode.IsDigit(rune(label[0])) {
+		return "_" + label
+	}
+	return label
+}
+
+// modelAttributes returns the attribute values of a resource model, by name.
+func modelAttributes(model interface{}) map[string]attr.Value {
+	v := reflect.Indirect(reflect.ValueOf(model))
+	t := v.Type()
+	values := make(map[string]attr.Value, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		name := t.Field(i).Tag.Get("tfsdk")
+		if name == "" || name == "-" {
+			continue
+		}
+		if av, ok := v.Field(i).Interface().(attr.Value); ok {
+			values[name] = av
+		}
+	}
+	return values
+}
+
+// sortedAttributeNames returns the attribute names, sorted, with the name first.
+func sortedAttributeNames(values map[string]attr.Value) []string {
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if names[i] == "name" || names[j] == "name" {
+			return names[i] == "name"
+		}
+		return names[i] < names[j]
+	})
+	return names
+}
+
+func isComputedOnly(a schema.Attribute) bool {
+	return a.IsComputed() && !a.IsOptional() && !a.IsRequired()
+}
+
+// nestedAttributes returns the attributes of the nested objects of an attribute.
+func nestedAttributes(a schema.Attribute) map[string]schema.Attribute {
+	switch na := a.(type) {
+	case schema.SingleNestedAttribute:
+		return na.Attributes
+	case schema.ListNestedAttribute:
+		return na.NestedObject.Attributes
+	case schema.SetNestedAttribute:
+		return na.NestedObject.Attributes
+	case schema.MapNestedAttribute:
+		return na.NestedObject.Attributes
+	default:
+		return nil
+	}
+}
+
+// valueTokens returns the HCL tokens of a value, or nil if the value is null.
+// The name of the attribute holding the value is used to find the references,
+// and varName is the name of the variable used if the value is sensitive.
+func (e *exporter) valueTokens(refs map[string]string, name string, a schema.Attribute, varName string, v attr.Value) hclwrite.Tokens {
+	if v == nil || v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	if a != nil && a.IsSensitive() {
+		e.variables[varName] = true
+		return hclwrite.TokensForTraversal(hcl.Traversal{
+			hcl.TraverseRoot{Name: "var"},
+			hcl.TraverseAttr{Name: varName},
+		})
+	}
+	var elemAttrs map[string]schema.Attribute
+	if a != nil {
+		elemAttrs = nestedAttributes(a)
+	}
+	switch tv := v.(type) {
+	case basetypes.StringValue:
+		if toks := e.referenceTokens(refs[name], tv.ValueString()); toks != nil {
+			return toks
+		}
+		return hclwrite.TokensForValue(cty.StringVal(tv.ValueString()))
+	case basetypes.Int64Value:
+		if toks := e.referenceTokens(refs[name], strconv.FormatInt(tv.ValueInt64(), 10)); toks != nil {
+			return toks
+		}
+		return hclwrite.TokensForValue(cty.NumberIntVal(tv.ValueInt64()))
+	case basetypes.Float64Value:
+		return hclwrite.TokensForValue(cty.NumberFloatVal(tv.ValueFloat64()))
+	case basetypes.BoolValue:
+		return hclwrite.TokensForValue(cty.BoolVal(tv.ValueBool()))
+	case basetypes.ListValue:
+		return e.tupleTokens(refs, name, elemAttrs, varName, tv.Elements())
+	case basetypes.SetValue:
+		return e.tupleTokens(refs, name, elemAttrs, varName, tv.Elements())
+	case basetypes.MapValue:
+		elems := tv.Elements()
+		keys := make([]string, 0, len(elems))
+		for k := range elems {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		attrToks := make([]hclwrite.ObjectAttrTokens, 0, len(keys))
+		for _, k := range keys {
+			var toks hclwrite.Tokens
+			if ov, ok := elems[k].(basetypes.ObjectValue); ok {
+				toks = e.objectTokens(refs, elemAttrs, varName+"_"+exportLabel(k), ov.Attributes())
+			} else {
+				toks = e.valueTokens(refs, name, nil, varName+"_"+exportLabel(k), elems[k])
+			}
+			if toks != nil {
+				attrToks = append(attrToks, hclwrite.ObjectAttrTokens{
+					Name:  hclwrite.TokensForValue(cty.StringVal(k)),
+					Value: toks,
+				})
+			}
+		}
+		return hclwrite.TokensForObject(attrToks)
+	case basetypes.ObjectValue:
+		return e.objectTokens(refs, elemAttrs, varName, tv.Attributes())
+	default:
+		return nil
+	}
+}
+
+func (e *exporter) tupleTokens(refs map[string]string, name string, elemAttrs map[string]schema.Attribute, varName string, elems []attr.Value) hclwrite.Tokens {
+	elemToks := make([]hclwrite.Tokens, 0, len(elems))
+	for i, elem := range elems {
+		elemVarName := fmt.Sprintf("%s_%d", varName, i)
+		var toks hclwrite.Tokens
+		if ov, ok := elem.(basetypes.ObjectValue); ok {
+			toks = e.objectTokens(refs, elemAttrs, elemVarName, ov.Attributes())
+		} else {
+			toks = e.valueTokens(refs, name, nil, elemVarName, elem)
+		}
+		if toks != nil {
+			elemToks = append(elemToks, toks)
+		}
+	}
+	return hclwrite.TokensForTuple(elemToks)
+}
+
+func (e *exporter) objectTokens(refs map[string]string, attrs map[string]schema.Attribute, varName string, values map[string]attr.Value) hclwrite.Tokens {
+	if values == nil {
+		return nil
+	}
+	attrToks := make([]hclwrite.ObjectAttrTokens, 0, len(values))
+	for _, name := range sortedAttributeNames(values) {
+		a := attrs[name]
+		if a != nil && isComputedOnly(a) {
+			continue
+		}
+		if toks := e.valueTokens(refs, name, a, varName+"_"+name, values[name]); toks != nil {
+			attrToks = append(attrToks, hclwrite.ObjectAttrTokens{
+				Name:  hclwrite.TokensForIdentifier(name),
+				Value: toks,
+			})
+		}
+	}
+	return hclwrite.TokensForObject(attrToks)
+}
+
+// referenceTokens returns the tokens of a reference to the ID of an exported
+// object, or nil if the object was not exported.
+func (e *exporter) referenceTokens(typeName string, id string) hclwrite.Tokens {
+	if typeName == "" {
+		return nil
+	}
+	label := e.Label(typeName, id)
+	if label == "" {
+		return nil
+	}
+	return hclwrite.TokensForTraversal(hcl.Traversal{
+		hcl.TraverseRoot{Name: typeName},
+		hcl.TraverseAttr{Name: label},
+		hcl.TraverseAttr{Name: "id"},
+	})
+}
@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type testExportModel struct {
+	ID       types.Int64  `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	ParentID types.Int64  `tfsdk:"parent_id"`
+	TagIDs   types.Set    `tfsdk:"tag_ids"`
+	Password types.String `tfsdk:"password"`
+	Comment  types.String `tfsdk:"comment"`
+}
+
+type testExportResource struct{}
+
+func (r *testExportResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "zentral_test"
+}
+
+func (r *testExportResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":        schema.Int64Attribute{Computed: true},
+			"name":      schema.StringAttribute{Required: true},
+			"parent_id": schema.Int64Attribute{Optional: true},
+			"tag_ids":   schema.SetAttribute{ElementType: types.Int64Type, Optional: true, Computed: true},
+			"password":  schema.StringAttribute{Optional: true, Sensitive: true},
+			"comment":   schema.StringAttribute{Optional: true},
+		},
+	}
+}
+
+func (r *testExportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+}
+
+func (r *testExportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+}
+
+func (r *testExportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+}
+
+func (r *testExportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+func testExportResources() []exportResource {
+	return []exportResource{
+		{
+			TypeName:    "zentral_test",
+			NewResource: func() resource.Resource { return &testExportResource{} },
+			References: map[string]string{
+				"parent_id": "zentral_test",
+				"tag_ids":   "zentral_test",
+			},
+			List: func(ctx context.Context, e *exporter) ([]exportObject, error) {
+				return []exportObject{
+					{"1", "Yolo Fomo", testExportModel{
+						ID:       types.Int64Value(1),
+						Name:     types.StringValue("Yolo Fomo"),
+						ParentID: types.Int64Null(),
+						TagIDs:   types.SetValueMust(types.Int64Type, []attr.Value{}),
+						Password: types.StringValue("secret"),
+						Comment:  types.StringNull(),
+					}},
+					{"2", "yolo-fomo", testExportModel{
+						ID:       types.Int64Value(2),
+						Name:     types.StringValue("yolo-fomo"),
+						ParentID: types.Int64Value(1),
+						TagIDs:   types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(1), types.Int64Value(3)}),
+						Password: types.StringNull(),
+						Comment:  types.StringValue("2"),
+					}},
+				}, nil
+			},
+		},
+	}
+}
+
+func TestExportLabel(t *testing.T) {
+	cases := map[string]string{
+		"Yolo Fomo":   "yolo_fomo",
+		"yolo--fomo!": "yolo_fomo",
+		"1 yolo":      "_1_yolo",
+		"???":         "object",
+		"Éclair":      "clair",
+	}
+	for name, want := range cases {
+		if got := exportLabel(name); got != want {
+			t.Errorf("exportLabel(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestExport(t *testing.T) {
+	dir := t.TempDir()
+	if err := newExporter().export(context.Background(), dir, testExportResources()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	b, err := os.ReadFile(filepath.Join(dir, "test.tf"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	got := string(b)
+	for _, want := range []string{
+		exportFileHeader,
+		"import {\n  to = zentral_test.yolo_fomo\n  id = \"1\"\n}\n",
+		"resource \"zentral_test\" \"yolo_fomo\" {\n  name     = \"Yolo Fomo\"\n  password = var.test_yolo_fomo_password\n  tag_ids  = []\n}\n",
+		"import {\n  to = zentral_test.yolo_fomo_2\n  id = \"2\"\n}\n",
+		"  parent_id = zentral_test.yolo_fomo.id\n",
+		"  tag_ids   = [zentral_test.yolo_fomo.id, 3]\n",
+		"  comment   = \"2\"\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("missing %q in:\n%s", want, got)
+		}
+	}
+
+	b, err = os.ReadFile(filepath.Join(dir, "variables.tf"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if want := "variable \"test_yolo_fomo_password\" {\n  sensitive = true\n}\n"; !strings.Contains(string(b), want) {
+		t.Errorf("missing %q in:\n%s", want, b)
+	}
+}
+
+func TestExportExistingFile(t *testing.T) {
+	dir := t.TempDir()
+	p := filepath.Join(dir, "test.tf")
+	if err := os.WriteFile(p, []byte("yolo"), 0o644); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	err := newExporter().export(context.Background(), dir, testExportResources())
+	if err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Fatalf("expected already exists error, got %v", err)
+	}
+	if b, _ := os.ReadFile(p); string(b) != "yolo" {
+		t.Fatalf("existing file overwritten")
+	}
+	if _, err := os.Stat(filepath.Join(dir, "variables.tf")); err == nil {
+		t.Fatalf("variables file written")
+	}
+}
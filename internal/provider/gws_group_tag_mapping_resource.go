@@ -119,8 +119,13 @@ func (r *GWSGroupTagMappingResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
-	ztlGTM, _, err := r.client.GWSGroupTagMappings.GetByID(ctx, data.ID.ValueString())
+	ztlGTM, ztlResp, err := r.client.GWSGroupTagMappings.GetByID(ctx, data.ID.ValueString())
 	if err != nil {
+		if isNotFound(ztlResp, err) {
+			tflog.Warn(ctx, "Google Workspace group tag mapping not found, removing it from the state", map[string]interface{}{"id": data.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Client error",
 			fmt.Sprintf("Unable to read Google Workspace group tag mapping %s, got error: %s", data.ID.ValueString(), err),
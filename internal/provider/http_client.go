@@ -0,0 +1,237 @@
+package provider
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	defaultMaxRetries   int           = 4
+	defaultRetryWaitMin time.Duration = 1 * time.Second
+	defaultRetryWaitMax time.Duration = 30 * time.Second
+)
+
+// httpClientConfig holds the settings of the HTTP client passed to goztl.
+type httpClientConfig struct {
+	MaxRetries        int
+	RetryWaitMin      time.Duration
+	RetryWaitMax      time.Duration
+	RequestsPerSecond float64
+	RequestTimeout    time.Duration
+
+	CACertPEM          string
+	ClientCertPEM      string
+	ClientKeyPEM       string
+	InsecureSkipVerify bool
+	ProxyURL           *url.URL
+}
+
+func defaultHTTPClientConfig() httpClientConfig {
+	return httpClientConfig{
+		MaxRetries:   defaultMaxRetries,
+		RetryWaitMin: defaultRetryWaitMin,
+		RetryWaitMax: defaultRetryWaitMax,
+	}
+}
+
+// newHTTPClient returns an HTTP client retrying the requests failing with
+// transient errors (connection errors, 429 and 5xx responses), with an
+// exponential backoff honouring the Retry-After response header, and
+// limiting the number of requests sent per second. The non-idempotent
+// requests are only retried when the server did not process them, see
+// checkRetry.
+func newHTTPClient(ctx context.Context, cfg httpClientConfig) (*http.Client, error) {
+	transport := cleanhttp.DefaultPooledTransport()
+
+	tlsConfig, err := newTLSConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	transport.TLSClientConfig = tlsConfig
+
+	if cfg.ProxyURL != nil {
+		transport.Proxy = http.ProxyURL(cfg.ProxyURL)
+	}
+
+	rc := retryablehttp.NewClient()
+	rc.HTTPClient = &http.Client{
+		Transport: newRateLimitedTransport(transport, cfg.RequestsPerSecond),
+		Timeout:   cfg.RequestTimeout,
+	}
+	rc.RetryMax = cfg.MaxRetries
+	rc.RetryWaitMin = cfg.RetryWaitMin
+	rc.RetryWaitMax = cfg.RetryWaitMax
+	rc.Logger = &tflogLogger{ctx: ctx}
+	rc.CheckRetry = checkRetry
+	// return the last response instead of a generic error once the
+	// retries are exhausted, for goztl to report the API error.
+	rc.ErrorHandler = retryablehttp.PassthroughErrorHandler
+
+	return &http.Client{
+		Transport: &methodContextTransport{
+			transport: &retryablehttp.RoundTripper{Client: rc},
+		},
+	}, nil
+}
+
+type methodContextKey struct{}
+
+// methodContextTransport adds the request method to the request context,
+// for checkRetry to know it even when no response was received.
+type methodContextTransport struct {
+	transport http.RoundTripper
+}
+
+func (t *methodContextTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := context.WithValue(req.Context(), methodContextKey{}, req.Method)
+	return t.transport.RoundTrip(req.WithContext(ctx))
+}
+
+// checkRetry applies the default retryablehttp policy to the idempotent
+// requests. A POST or PATCH request could have been committed by the server
+// before its response was lost, and retrying it could create a duplicate.
+// They are only retried on 429 responses, or on 503 responses with a
+// Retry-After header.
+func checkRetry(ctx context.Context, resp *http.Response, err error) (bool, error) {
+	method, _ := ctx.Value(methodContextKey{}).(string)
+	if method != http.MethodPost && method != http.MethodPatch {
+		return retryablehttp.DefaultRetryPolicy(ctx, resp, err)
+	}
+
+	// do not retry on context.Canceled or context.DeadlineExceeded
+	if ctx.Err() != nil {
+		return false, ctx.Err()
+	}
+
+	if err != nil || resp == nil {
+		return false, nil
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return true, nil
+	case http.StatusServiceUnavailable:
+		return resp.Header.Get("Retry-After") != "", nil
+	default:
+		return false, nil
+	}
+}
+
+// newTLSConfig returns the TLS configuration with the optional custom CA
+// bundle and client certificate.
+func newTLSConfig(cfg httpClientConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: cfg.InsecureSkipVerify,
+	}
+
+	if cfg.CACertPEM != "" {
+		// the custom CA bundle is added to the system CAs, if available
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM([]byte(cfg.CACertPEM)) {
+			return nil, errors.New("no valid PEM certificate found in the CA bundle")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if cfg.ClientCertPEM != "" || cfg.ClientKeyPEM != "" {
+		if cfg.ClientCertPEM == "" || cfg.ClientKeyPEM == "" {
+			return nil, errors.New("the client certificate and the client key must be both set")
+		}
+		cert, err := tls.X509KeyPair([]byte(cfg.ClientCertPEM), []byte(cfg.ClientKeyPEM))
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate or key: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+// rateLimitedTransport spaces out the requests to send at most
+// requestsPerSecond requests per second.
+type rateLimitedTransport struct {
+	transport http.RoundTripper
+	interval  time.Duration
+
+	mu   sync.Mutex
+	next time.Time
+}
+
+func newRateLimitedTransport(transport http.RoundTripper, requestsPerSecond float64) http.RoundTripper {
+	if requestsPerSecond <= 0 {
+		return transport
+	}
+	return &rateLimitedTransport{
+		transport: transport,
+		interval:  time.Duration(float64(time.Second) / requestsPerSecond),
+	}
+}
+
+func (t *rateLimitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.mu.Lock()
+	now := time.Now()
+	if t.next.Before(now) {
+		t.next = now
+	}
+	wait := t.next.Sub(now)
+	t.next = t.next.Add(t.interval)
+	t.mu.Unlock()
+
+	if wait > 0 {
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+
+	return t.transport.RoundTrip(req)
+}
+
+// tflogLogger sends the retryablehttp logs to the Terraform logs.
+type tflogLogger struct {
+	ctx context.Context
+}
+
+func tflogFields(keysAndValues []interface{}) map[string]interface{} {
+	fields := make(map[string]interface{})
+	for i := 0; i+1 < len(keysAndValues); i += 2 {
+		if k, ok := keysAndValues[i].(string); ok {
+			fields[k] = keysAndValues[i+1]
+		}
+	}
+	return fields
+}
+
+func (l *tflogLogger) Error(msg string, keysAndValues ...interface{}) {
+	tflog.Error(l.ctx, msg, tflogFields(keysAndValues))
+}
+
+func (l *tflogLogger) Info(msg string, keysAndValues ...interface{}) {
+	tflog.Info(l.ctx, msg, tflogFields(keysAndValues))
+}
+
+func (l *tflogLogger) Debug(msg string, keysAndValues ...interface{}) {
+	tflog.Debug(l.ctx, msg, tflogFields(keysAndValues))
+}
+
+func (l *tflogLogger) Warn(msg string, keysAndValues ...interface{}) {
+	tflog.Warn(l.ctx, msg, tflogFields(keysAndValues))
+}
@@ -0,0 +1,409 @@
+package provider
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func testHTTPClientConfig() httpClientConfig {
+	return httpClientConfig{
+		MaxRetries:   2,
+		RetryWaitMin: 10 * time.Millisecond,
+		RetryWaitMax: 50 * time.Millisecond,
+	}
+}
+
+func testNewHTTPClient(t *testing.T, cfg httpClientConfig) *http.Client {
+	c, err := newHTTPClient(context.Background(), cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	return c
+}
+
+func TestHTTPClientRetriesTransientErrors(t *testing.T) {
+	var count int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch atomic.AddInt32(&count, 1) {
+		case 1:
+			w.WriteHeader(http.StatusBadGateway)
+		case 2:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	defer ts.Close()
+
+	c := testNewHTTPClient(t, testHTTPClientConfig())
+	resp, err := c.Get(ts.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("expected status code 200, got %d", resp.StatusCode)
+	}
+	if count != 3 {
+		t.Errorf("expected 3 requests, got %d", count)
+	}
+}
+
+func TestHTTPClientReturnsLastResponseAfterMaxRetries(t *testing.T) {
+	var count int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&count, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer ts.Close()
+
+	c := testNewHTTPClient(t, testHTTPClientConfig())
+	resp, err := c.Get(ts.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("expected status code 503, got %d", resp.StatusCode)
+	}
+	if count != 3 {
+		t.Errorf("expected 3 requests, got %d", count)
+	}
+}
+
+func TestHTTPClientDoesNotRetryClientErrors(t *testing.T) {
+	var count int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&count, 1)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer ts.Close()
+
+	c := testNewHTTPClient(t, testHTTPClientConfig())
+	resp, err := c.Get(ts.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("expected status code 404, got %d", resp.StatusCode)
+	}
+	if count != 1 {
+		t.Errorf("expected 1 request, got %d", count)
+	}
+}
+
+func TestHTTPClientNonIdempotentRequests(t *testing.T) {
+	cases := []struct {
+		name       string
+		method     string
+		statusCode int
+		retryAfter string
+		expected   int32
+	}{
+		{"POST 502", http.MethodPost, http.StatusBadGateway, "", 1},
+		{"POST 503", http.MethodPost, http.StatusServiceUnavailable, "", 1},
+		{"POST 503 Retry-After", http.MethodPost, http.StatusServiceUnavailable, "0", 3},
+		{"POST 429", http.MethodPost, http.StatusTooManyRequests, "", 3},
+		{"PATCH 500", http.MethodPatch, http.StatusInternalServerError, "", 1},
+		{"PATCH 429", http.MethodPatch, http.StatusTooManyRequests, "0", 3},
+		{"PUT 500", http.MethodPut, http.StatusInternalServerError, "", 3},
+		{"DELETE 502", http.MethodDelete, http.StatusBadGateway, "", 3},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var count int32
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&count, 1)
+				if c.retryAfter != "" {
+					w.Header().Set("Retry-After", c.retryAfter)
+				}
+				w.WriteHeader(c.statusCode)
+			}))
+			defer ts.Close()
+
+			client := testNewHTTPClient(t, testHTTPClientConfig())
+			req, err := http.NewRequest(c.method, ts.URL, strings.NewReader("{}"))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != c.statusCode {
+				t.Errorf("expected status code %d, got %d", c.statusCode, resp.StatusCode)
+			}
+			if count != c.expected {
+				t.Errorf("expected %d requests, got %d", c.expected, count)
+			}
+		})
+	}
+}
+
+func TestHTTPClientDoesNotRetryLostPOSTResponses(t *testing.T) {
+	var count int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&count, 1)
+		// close the connection without a response
+		conn, _, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			t.Errorf("unexpected error: %s", err)
+			return
+		}
+		conn.Close()
+	}))
+	defer ts.Close()
+
+	c := testNewHTTPClient(t, testHTTPClientConfig())
+	if resp, err := c.Post(ts.URL, "application/json", strings.NewReader("{}")); err == nil {
+		resp.Body.Close()
+		t.Fatal("expected an error")
+	}
+	if count != 1 {
+		t.Errorf("expected 1 POST request, got %d", count)
+	}
+
+	count = 0
+	if resp, err := c.Get(ts.URL); err == nil {
+		resp.Body.Close()
+		t.Fatal("expected an error")
+	}
+	if count != 3 {
+		t.Errorf("expected 3 GET requests, got %d", count)
+	}
+}
+
+func TestHTTPClientHonoursRetryAfter(t *testing.T) {
+	var count int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&count, 1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	c := testNewHTTPClient(t, testHTTPClientConfig())
+	start := time.Now()
+	resp, err := c.Get(ts.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("expected status code 200, got %d", resp.StatusCode)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("expected to wait at least 1s, waited %s", elapsed)
+	}
+}
+
+func TestHTTPClientRateLimit(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	cfg := testHTTPClientConfig()
+	cfg.RequestsPerSecond = 20
+	c := testNewHTTPClient(t, cfg)
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		resp, err := c.Get(ts.URL)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		resp.Body.Close()
+	}
+
+	// 5 requests at 20 requests per second → 4 intervals of 50ms
+	if elapsed := time.Since(start); elapsed < 200*time.Millisecond {
+		t.Errorf("expected the requests to take at least 200ms, took %s", elapsed)
+	}
+}
+
+func TestHTTPClientRequestTimeout(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	cfg := testHTTPClientConfig()
+	cfg.MaxRetries = 0
+	cfg.RequestTimeout = 50 * time.Millisecond
+	c := testNewHTTPClient(t, cfg)
+	resp, err := c.Get(ts.URL)
+	if err == nil {
+		resp.Body.Close()
+		t.Fatal("expected a timeout error")
+	}
+}
+
+func testServerCACertPEM(ts *httptest.Server) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw}))
+}
+
+func testClientCertificate(t *testing.T) (*x509.Certificate, string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return cert, string(certPEM), string(keyPEM)
+}
+
+func TestHTTPClientCustomCA(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	// unknown CA
+	cfg := testHTTPClientConfig()
+	cfg.MaxRetries = 0
+	c := testNewHTTPClient(t, cfg)
+	if resp, err := c.Get(ts.URL); err == nil {
+		resp.Body.Close()
+		t.Fatal("expected a certificate verification error")
+	}
+
+	// custom CA
+	cfg.CACertPEM = testServerCACertPEM(ts)
+	c = testNewHTTPClient(t, cfg)
+	resp, err := c.Get(ts.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	// insecure skip verify
+	cfg.CACertPEM = ""
+	cfg.InsecureSkipVerify = true
+	c = testNewHTTPClient(t, cfg)
+	resp, err = c.Get(ts.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+}
+
+func TestHTTPClientClientCertificate(t *testing.T) {
+	clientCert, clientCertPEM, clientKeyPEM := testClientCertificate(t)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(clientCert)
+
+	ts := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	ts.TLS = &tls.Config{
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  clientCAs,
+	}
+	ts.StartTLS()
+	defer ts.Close()
+
+	// no client certificate
+	cfg := testHTTPClientConfig()
+	cfg.MaxRetries = 0
+	cfg.CACertPEM = testServerCACertPEM(ts)
+	c := testNewHTTPClient(t, cfg)
+	if resp, err := c.Get(ts.URL); err == nil {
+		resp.Body.Close()
+		t.Fatal("expected a TLS handshake error")
+	}
+
+	// client certificate
+	cfg.ClientCertPEM = clientCertPEM
+	cfg.ClientKeyPEM = clientKeyPEM
+	c = testNewHTTPClient(t, cfg)
+	resp, err := c.Get(ts.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+}
+
+func TestHTTPClientInvalidTLSConfig(t *testing.T) {
+	_, clientCertPEM, clientKeyPEM := testClientCertificate(t)
+	for name, cfg := range map[string]httpClientConfig{
+		"invalid CA":          {CACertPEM: "yolo"},
+		"missing client key":  {ClientCertPEM: clientCertPEM},
+		"missing client cert": {ClientKeyPEM: clientKeyPEM},
+		"invalid client key":  {ClientCertPEM: clientCertPEM, ClientKeyPEM: "fomo"},
+	} {
+		if _, err := newHTTPClient(context.Background(), cfg); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestHTTPClientProxy(t *testing.T) {
+	var proxiedURL string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxiedURL = r.URL.String()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer proxy.Close()
+
+	proxyURL, err := url.Parse(proxy.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	cfg := testHTTPClientConfig()
+	cfg.ProxyURL = proxyURL
+	c := testNewHTTPClient(t, cfg)
+	resp, err := c.Get("http://zentral.example.com/api/inventory/tags/")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	if proxiedURL != "http://zentral.example.com/api/inventory/tags/" {
+		t.Errorf("unexpected proxied URL: %q", proxiedURL)
+	}
+}
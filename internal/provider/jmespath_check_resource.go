@@ -161,8 +161,13 @@ func (r *JMESPathCheckResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
-	ztlJC, _, err := r.client.JMESPathChecks.GetByID(ctx, int(data.ID.ValueInt64()))
+	ztlJC, ztlResp, err := r.client.JMESPathChecks.GetByID(ctx, int(data.ID.ValueInt64()))
 	if err != nil {
+		if isNotFound(ztlResp, err) {
+			tflog.Warn(ctx, "JMESPath check not found, removing it from the state", map[string]interface{}{"id": data.ID.ValueInt64()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Client error",
 			fmt.Sprintf("Unable to read JMESPath check %d, got error: %s", data.ID.ValueInt64(), err),
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestJSONStringSemanticEquals(t *testing.T) {
+	ctx := context.Background()
+	v := newJSONStringValue(`{"Type":"com.apple.configuration.passcode.settings","Payload":{"MinimumLength":10}}`)
+
+	cases := []struct {
+		name     string
+		value    string
+		expected bool
+	}{
+		{"same", `{"Type":"com.apple.configuration.passcode.settings","Payload":{"MinimumLength":10}}`, true},
+		{"formatting", "{\n  \"Payload\": {\"MinimumLength\": 10},\n  \"Type\": \"com.apple.configuration.passcode.settings\"\n}\n", true},
+		{"different value", `{"Type":"com.apple.configuration.passcode.settings","Payload":{"MinimumLength":11}}`, false},
+		{"invalid JSON", "yolo", false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			equal, diags := v.StringSemanticEquals(ctx, newJSONStringValue(c.value))
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if equal != c.expected {
+				t.Errorf("expected %t, got %t", c.expected, equal)
+			}
+		})
+	}
+
+	if _, diags := v.StringSemanticEquals(ctx, types.StringValue("{}")); !diags.HasError() {
+		t.Error("expected an error with a value of another type")
+	}
+}
+
+func TestJSONStringTypeValueFromTerraform(t *testing.T) {
+	ctx := context.Background()
+	value, err := jsonStringType{}.ValueFromTerraform(ctx, tftypes.NewValue(tftypes.String, "{}"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !value.Equal(newJSONStringValue("{}")) {
+		t.Errorf("unexpected value %v", value)
+	}
+}
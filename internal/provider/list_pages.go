@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/zentralopensource/goztl"
+)
+
+// listPageSize is the number of objects requested per page.
+const listPageSize = 100
+
+// listAllPages returns the objects of all the pages of a list endpoint, to
+// avoid truncated exports and data sources. The pages are requested until one
+// has less than listPageSize objects. If the endpoint is not paginated, the first page
+// already contains all the objects: it has more than listPageSize objects,
+// or it is returned again for the second page.
+func listAllPages[T any](ctx context.Context, list func(context.Context, goztl.ListOptions) ([]T, *goztl.Response, error)) ([]T, error) {
+	var all, previous []T
+	for page := 1; ; page++ {
+		objs, _, err := list(ctx, goztl.ListOptions{Page: page, PerPage: listPageSize})
+		if err != nil {
+			return nil, err
+		}
+		if page == 1 && len(objs) > listPageSize {
+			// not paginated
+			return objs, nil
+		}
+		if page > 1 && reflect.DeepEqual(objs, previous) {
+			// page parameters ignored
+			return all, nil
+		}
+		all = append(all, objs...)
+		if len(objs) < listPageSize {
+			return all, nil
+		}
+		previous = objs
+	}
+}
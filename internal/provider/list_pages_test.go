@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/zentralopensource/goztl"
+)
+
+func testListPages(count int, paginated bool, requests *int) func(context.Context, goztl.ListOptions) ([]int, *goztl.Response, error) {
+	return func(ctx context.Context, opt goztl.ListOptions) ([]int, *goztl.Response, error) {
+		*requests++
+		objs := make([]int, 0)
+		start, end := 0, count
+		if paginated {
+			start = (opt.Page - 1) * opt.PerPage
+			end = min(start+opt.PerPage, count)
+		}
+		for i := start; i < end; i++ {
+			objs = append(objs, i)
+		}
+		return objs, nil, nil
+	}
+}
+
+func TestListAllPages(t *testing.T) {
+	cases := []struct {
+		name             string
+		count            int
+		paginated        bool
+		expectedRequests int
+	}{
+		{"paginated empty", 0, true, 1},
+		{"paginated one page", 42, true, 1},
+		{"paginated full page", listPageSize, true, 2},
+		{"paginated three pages", 2*listPageSize + 1, true, 3},
+		{"not paginated", 2*listPageSize + 1, false, 1},
+		{"not paginated full page", listPageSize, false, 2},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			requests := 0
+			objs, err := listAllPages(context.Background(), testListPages(c.count, c.paginated, &requests))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if len(objs) != c.count {
+				t.Errorf("expected %d objects, got %d", c.count, len(objs))
+			}
+			for i, obj := range objs {
+				if obj != i {
+					t.Fatalf("expected object %d at index %d, got %d", i, i, obj)
+				}
+			}
+			if requests != c.expectedRequests {
+				t.Errorf("expected %d requests, got %d", c.expectedRequests, requests)
+			}
+		})
+	}
+}
+
+func TestListAllPagesError(t *testing.T) {
+	_, err := listAllPages(context.Background(), func(ctx context.Context, opt goztl.ListOptions) ([]int, *goztl.Response, error) {
+		if opt.Page == 2 {
+			return nil, nil, errors.New("yolo")
+		}
+		return make([]int, listPageSize), nil, nil
+	})
+	if err == nil || err.Error() != "yolo" {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
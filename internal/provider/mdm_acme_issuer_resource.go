@@ -190,8 +190,13 @@ func (r *MDMACMEIssuerResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
-	ztlMAI, _, err := r.client.MDMACMEIssuers.GetByID(ctx, data.ID.ValueString())
+	ztlMAI, ztlResp, err := r.client.MDMACMEIssuers.GetByID(ctx, data.ID.ValueString())
 	if err != nil {
+		if isNotFound(ztlResp, err) {
+			tflog.Warn(ctx, "ACME issuer not found, removing it from the state", map[string]interface{}{"id": data.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Client error",
 			fmt.Sprintf("Unable to read ACME issuer %s, got error: %s", data.ID.ValueString(), err),
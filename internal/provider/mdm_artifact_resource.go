@@ -193,8 +193,13 @@ func (r *MDMArtifactResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	ztlMA, _, err := r.client.MDMArtifacts.GetByID(ctx, data.ID.ValueString())
+	ztlMA, ztlResp, err := r.client.MDMArtifacts.GetByID(ctx, data.ID.ValueString())
 	if err != nil {
+		if isNotFound(ztlResp, err) {
+			tflog.Warn(ctx, "MDM artifact not found, removing it from the state", map[string]interface{}{"id": data.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Client error",
 			fmt.Sprintf("Unable to read MDM artifact %s, got error: %s", data.ID.ValueString(), err),
@@ -1,6 +1,8 @@
 package provider
 
 import (
+	"fmt"
+
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/zentralopensource/goztl"
@@ -39,3 +41,106 @@ func tagShardsWithState(ts types.Set) []goztl.TagShard {
 	}
 	return tagShards
 }
+
+// Scoping of the blueprint artifacts and artifact versions
+
+// mdmDevice is the device used to resolve the scoping.
+type mdmDevice struct {
+	Platform     string
+	OSVersion    []int
+	SerialNumber string
+	TagIDs       map[int]bool
+}
+
+type mdmArtifactPlatformScope struct {
+	Enabled    bool
+	MinVersion string
+	MaxVersion string
+}
+
+// mdmArtifactScope holds the platforms, OS versions, excluded tags and
+// shards of a blueprint artifact or of an artifact version.
+type mdmArtifactScope struct {
+	Platforms      map[string]mdmArtifactPlatformScope
+	DefaultShard   int
+	ShardModulo    int
+	ExcludedTagIDs []int
+	TagShards      []goztl.TagShard
+}
+
+func mdmArtifactScopeWithBlueprintArtifact(mba *goztl.MDMBlueprintArtifact) mdmArtifactScope {
+	return mdmArtifactScope{
+		Platforms: map[string]mdmArtifactPlatformScope{
+			"iOS":    {mba.IOS, mba.IOSMinVersion, mba.IOSMaxVersion},
+			"iPadOS": {mba.IPadOS, mba.IPadOSMinVersion, mba.IPadOSMaxVersion},
+			"macOS":  {mba.MacOS, mba.MacOSMinVersion, mba.MacOSMaxVersion},
+			"tvOS":   {mba.TVOS, mba.TVOSMinVersion, mba.TVOSMaxVersion},
+		},
+		DefaultShard:   mba.DefaultShard,
+		ShardModulo:    mba.ShardModulo,
+		ExcludedTagIDs: mba.ExcludedTagIDs,
+		TagShards:      mba.TagShards,
+	}
+}
+
+func mdmArtifactScopeWithVersion(mav goztl.MDMArtifactVersion) mdmArtifactScope {
+	return mdmArtifactScope{
+		Platforms: map[string]mdmArtifactPlatformScope{
+			"iOS":    {mav.IOS, mav.IOSMinVersion, mav.IOSMaxVersion},
+			"iPadOS": {mav.IPadOS, mav.IPadOSMinVersion, mav.IPadOSMaxVersion},
+			"macOS":  {mav.MacOS, mav.MacOSMinVersion, mav.MacOSMaxVersion},
+			"tvOS":   {mav.TVOS, mav.TVOSMinVersion, mav.TVOSMaxVersion},
+		},
+		DefaultShard:   mav.DefaultShard,
+		ShardModulo:    mav.ShardModulo,
+		ExcludedTagIDs: mav.ExcludedTagIDs,
+		TagShards:      mav.TagShards,
+	}
+}
+
+// resolve returns true if the device is in scope, and the reason why it is
+// included or excluded. The key is used to compute the device shard.
+func (s mdmArtifactScope) resolve(d mdmDevice, key string) (bool, string) {
+	ps := s.Platforms[d.Platform]
+	if !ps.Enabled {
+		return false, fmt.Sprintf("%s not enabled", d.Platform)
+	}
+	for _, bound := range []struct {
+		name    string
+		version string
+		exclude func(int) bool
+	}{
+		{"min", ps.MinVersion, func(c int) bool { return c < 0 }},
+		{"max", ps.MaxVersion, func(c int) bool { return c >= 0 }},
+	} {
+		if bound.version == "" {
+			continue
+		}
+		v, err := parseMDMArtifactVersionOSVersion(bound.version)
+		if err != nil {
+			return false, fmt.Sprintf("invalid %s %s version: %s", d.Platform, bound.name, err)
+		}
+		if bound.exclude(compareMDMArtifactVersionOSVersions(d.OSVersion, v)) {
+			return false, fmt.Sprintf("OS version outside of the %s %s version %s", d.Platform, bound.name, bound.version)
+		}
+	}
+	for _, tagID := range s.ExcludedTagIDs {
+		if d.TagIDs[tagID] {
+			return false, fmt.Sprintf("excluded tag %d", tagID)
+		}
+	}
+	shard := s.DefaultShard
+	for _, ts := range s.TagShards {
+		if d.TagIDs[ts.TagID] && ts.Shard > shard {
+			shard = ts.Shard
+		}
+	}
+	if shard >= s.ShardModulo {
+		return true, fmt.Sprintf("shard %d/%d includes all the devices", shard, s.ShardModulo)
+	}
+	deviceShard := zentralShard(d.SerialNumber, key, int64(s.ShardModulo))
+	if deviceShard < int64(shard) {
+		return true, fmt.Sprintf("device shard %d below shard %d/%d", deviceShard, shard, s.ShardModulo)
+	}
+	return false, fmt.Sprintf("device shard %d not below shard %d/%d", deviceShard, shard, s.ShardModulo)
+}
@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var mdmArtifactVersionOSVersionRe = regexp.MustCompile(`^\d+(\.\d+){0,2}$`)
+
+var mdmArtifactVersionPlatforms = []struct {
+	attr string
+	name string
+}{
+	{"ios", "iOS"},
+	{"ipados", "iPadOS"},
+	{"macos", "macOS"},
+	{"tvos", "tvOS"},
+}
+
+// mdmArtifactVersionConfigValidators returns the config validators shared by
+// the resources managing MDM artifact versions.
+func mdmArtifactVersionConfigValidators() []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		mdmArtifactVersionOSVersionsValidator{},
+		mdmArtifactVersionShardsValidator{},
+	}
+}
+
+// parseMDMArtifactVersionOSVersion returns the components of an OS version like 13.3.1.
+func parseMDMArtifactVersionOSVersion(v string) ([]int, error) {
+	if !mdmArtifactVersionOSVersionRe.MatchString(v) {
+		return nil, fmt.Errorf("%q is not a valid OS version, expected a version like 13, 13.3 or 13.3.1", v)
+	}
+	var components []int
+	for _, s := range strings.Split(v, ".") {
+		i, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a valid OS version: %w", v, err)
+		}
+		components = append(components, i)
+	}
+	return components, nil
+}
+
+// compareMDMArtifactVersionOSVersions compares two parsed OS versions. The
+// missing components are considered to be 0.
+func compareMDMArtifactVersionOSVersions(a []int, b []int) int {
+	for i := 0; i < len(a) || i < len(b); i++ {
+		var ai, bi int
+		if i < len(a) {
+			ai = a[i]
+		}
+		if i < len(b) {
+			bi = b[i]
+		}
+		if ai != bi {
+			if ai < bi {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// OS versions validator
+
+var _ resource.ConfigValidator = mdmArtifactVersionOSVersionsValidator{}
+
+// mdmArtifactVersionOSVersionsValidator verifies the syntax of the min and
+// max OS versions, that the min version is below the max version, and that
+// the versions are only set for the enabled platforms.
+type mdmArtifactVersionOSVersionsValidator struct{}
+
+func (v mdmArtifactVersionOSVersionsValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v mdmArtifactVersionOSVersionsValidator) MarkdownDescription(_ context.Context) string {
+	return "OS versions must be valid, the min version must be below the max version, and the versions can only be set for the enabled platforms"
+}
+
+func (v mdmArtifactVersionOSVersionsValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	for _, platform := range mdmArtifactVersionPlatforms {
+		var enabled types.Bool
+		diags := req.Config.GetAttribute(ctx, path.Root(platform.attr), &enabled)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			return
+		}
+
+		versions := make(map[string][]int)
+		for _, bound := range []string{"min", "max"} {
+			attrPath := path.Root(fmt.Sprintf("%s_%s_version", platform.attr, bound))
+			var version types.String
+			diags := req.Config.GetAttribute(ctx, attrPath, &version)
+			resp.Diagnostics.Append(diags...)
+			if diags.HasError() {
+				return
+			}
+			if version.IsNull() || version.IsUnknown() || version.ValueString() == "" {
+				continue
+			}
+			if !enabled.IsUnknown() && !enabled.ValueBool() {
+				resp.Diagnostics.AddAttributeError(
+					attrPath,
+					"Invalid OS version range",
+					fmt.Sprintf("The %s %s version cannot be set if %s is not enabled.", platform.name, bound, platform.attr),
+				)
+			}
+			components, err := parseMDMArtifactVersionOSVersion(version.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(attrPath, "Invalid OS version", err.Error())
+				continue
+			}
+			versions[bound] = components
+		}
+
+		minVersion, minOk := versions["min"]
+		maxVersion, maxOk := versions["max"]
+		if minOk && maxOk && compareMDMArtifactVersionOSVersions(minVersion, maxVersion) >= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(platform.attr+"_min_version"),
+				"Invalid OS version range",
+				fmt.Sprintf("The %s min version must be below the %s max version.", platform.name, platform.name),
+			)
+		}
+	}
+}
+
+// Shards validator
+
+var _ resource.ConfigValidator = mdmArtifactVersionShardsValidator{}
+
+// mdmArtifactVersionShardsValidator verifies that the default shard and the
+// tag shards are not above the shard modulo, and that the tags are unique.
+// A shard equal to the modulo, like the default ones, includes all the devices.
+type mdmArtifactVersionShardsValidator struct{}
+
+func (v mdmArtifactVersionShardsValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v mdmArtifactVersionShardsValidator) MarkdownDescription(_ context.Context) string {
+	return "the default shard and the tag shards must not be above the shard modulo, and each tag can only have one shard"
+}
+
+func (v mdmArtifactVersionShardsValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var shardModulo, defaultShard types.Int64
+	var tagShards types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("shard_modulo"), &shardModulo)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("default_shard"), &defaultShard)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("tag_shards"), &tagShards)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// same default as in the resource schemas
+	modulo := int64(100)
+	moduloKnown := !shardModulo.IsUnknown()
+	if !shardModulo.IsNull() {
+		modulo = shardModulo.ValueInt64()
+	}
+
+	if moduloKnown && !defaultShard.IsNull() && !defaultShard.IsUnknown() && defaultShard.ValueInt64() > modulo {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("default_shard"),
+			"Invalid shard",
+			fmt.Sprintf("The default shard %d must be less than or equal to the shard modulo %d.", defaultShard.ValueInt64(), modulo),
+		)
+	}
+
+	seenTagIDs := make(map[int64]bool)
+	for _, tagShard := range tagShards.Elements() { // nil if null or unknown → no iterations
+		tagShardObj, ok := tagShard.(types.Object)
+		if !ok || tagShardObj.IsNull() || tagShardObj.IsUnknown() {
+			continue
+		}
+		attrs := tagShardObj.Attributes()
+		tagID, _ := attrs["tag_id"].(types.Int64)
+		shard, _ := attrs["shard"].(types.Int64)
+		if moduloKnown && !shard.IsNull() && !shard.IsUnknown() && shard.ValueInt64() > modulo {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("tag_shards"),
+				"Invalid shard",
+				fmt.Sprintf("The tag shard %d must be less than or equal to the shard modulo %d.", shard.ValueInt64(), modulo),
+			)
+		}
+		if tagID.IsNull() || tagID.IsUnknown() {
+			continue
+		}
+		if seenTagIDs[tagID.ValueInt64()] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("tag_shards"),
+				"Duplicate tag shard",
+				fmt.Sprintf("The tag %d has more than one shard.", tagID.ValueInt64()),
+			)
+		}
+		seenTagIDs[tagID.ValueInt64()] = true
+	}
+}
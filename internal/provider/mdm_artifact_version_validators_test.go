@@ -0,0 +1,196 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func testMDMArtifactVersionConfig(t *testing.T, values map[string]tftypes.Value) tfsdk.Config {
+	ctx := context.Background()
+	attrs := map[string]schema.Attribute{
+		"shard_modulo":  schema.Int64Attribute{Optional: true},
+		"default_shard": schema.Int64Attribute{Optional: true},
+		"tag_shards": schema.SetNestedAttribute{
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"tag_id": schema.Int64Attribute{Required: true},
+					"shard":  schema.Int64Attribute{Required: true},
+				},
+			},
+			Optional: true,
+		},
+	}
+	for _, platform := range mdmArtifactVersionPlatforms {
+		attrs[platform.attr] = schema.BoolAttribute{Optional: true}
+		attrs[platform.attr+"_min_version"] = schema.StringAttribute{Optional: true}
+		attrs[platform.attr+"_max_version"] = schema.StringAttribute{Optional: true}
+	}
+	s := schema.Schema{Attributes: attrs}
+	objType := s.Type().TerraformType(ctx).(tftypes.Object)
+	rawValues := make(map[string]tftypes.Value)
+	for name, attrType := range objType.AttributeTypes {
+		if v, ok := values[name]; ok {
+			rawValues[name] = v
+		} else {
+			rawValues[name] = tftypes.NewValue(attrType, nil)
+		}
+	}
+	return tfsdk.Config{Schema: s, Raw: tftypes.NewValue(objType, rawValues)}
+}
+
+func testMDMArtifactVersionTagShards(tagShards ...[2]int64) tftypes.Value {
+	objType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"tag_id": tftypes.Number, "shard": tftypes.Number}}
+	var elems []tftypes.Value
+	for _, ts := range tagShards {
+		elems = append(elems, tftypes.NewValue(objType, map[string]tftypes.Value{
+			"tag_id": tftypes.NewValue(tftypes.Number, ts[0]),
+			"shard":  tftypes.NewValue(tftypes.Number, ts[1]),
+		}))
+	}
+	return tftypes.NewValue(tftypes.Set{ElementType: objType}, elems)
+}
+
+func TestMDMArtifactVersionConfigValidators(t *testing.T) {
+	cases := []struct {
+		name   string
+		values map[string]tftypes.Value
+		errors []string
+	}{
+		{
+			"valid",
+			map[string]tftypes.Value{
+				"macos":             tftypes.NewValue(tftypes.Bool, true),
+				"macos_min_version": tftypes.NewValue(tftypes.String, "13.3.1"),
+				"macos_max_version": tftypes.NewValue(tftypes.String, "14"),
+				"ios_min_version":   tftypes.NewValue(tftypes.String, ""),
+				"shard_modulo":      tftypes.NewValue(tftypes.Number, 5),
+				"default_shard":     tftypes.NewValue(tftypes.Number, 5),
+				"tag_shards":        testMDMArtifactVersionTagShards([2]int64{1, 4}, [2]int64{2, 0}),
+			},
+			nil,
+		},
+		{
+			"defaults",
+			nil,
+			nil,
+		},
+		{
+			"unknown values",
+			map[string]tftypes.Value{
+				"macos":             tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue),
+				"macos_min_version": tftypes.NewValue(tftypes.String, "13"),
+				"shard_modulo":      tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+				"default_shard":     tftypes.NewValue(tftypes.Number, 1000),
+			},
+			nil,
+		},
+		{
+			"invalid version",
+			map[string]tftypes.Value{
+				"ios":             tftypes.NewValue(tftypes.Bool, true),
+				"ios_max_version": tftypes.NewValue(tftypes.String, "17.a"),
+			},
+			[]string{`"17.a" is not a valid OS version`},
+		},
+		{
+			"min above max",
+			map[string]tftypes.Value{
+				"tvos":             tftypes.NewValue(tftypes.Bool, true),
+				"tvos_min_version": tftypes.NewValue(tftypes.String, "17.1"),
+				"tvos_max_version": tftypes.NewValue(tftypes.String, "17.1.0"),
+			},
+			[]string{"The tvOS min version must be below the tvOS max version."},
+		},
+		{
+			"disabled platform",
+			map[string]tftypes.Value{
+				"ipados":             tftypes.NewValue(tftypes.Bool, false),
+				"ipados_min_version": tftypes.NewValue(tftypes.String, "17"),
+				"macos_max_version":  tftypes.NewValue(tftypes.String, "15"),
+			},
+			[]string{
+				"The iPadOS min version cannot be set if ipados is not enabled.",
+				"The macOS max version cannot be set if macos is not enabled.",
+			},
+		},
+		{
+			"shards",
+			map[string]tftypes.Value{
+				"shard_modulo":  tftypes.NewValue(tftypes.Number, 10),
+				"default_shard": tftypes.NewValue(tftypes.Number, 11),
+				"tag_shards":    testMDMArtifactVersionTagShards([2]int64{1, 4}, [2]int64{1, 12}),
+			},
+			[]string{
+				"The default shard 11 must be less than or equal to the shard modulo 10.",
+				"The tag shard 12 must be less than or equal to the shard modulo 10.",
+				"The tag 1 has more than one shard.",
+			},
+		},
+		{
+			"default modulo",
+			map[string]tftypes.Value{
+				"default_shard": tftypes.NewValue(tftypes.Number, 101),
+			},
+			[]string{"The default shard 101 must be less than or equal to the shard modulo 100."},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.Background()
+			req := resource.ValidateConfigRequest{Config: testMDMArtifactVersionConfig(t, c.values)}
+			resp := &resource.ValidateConfigResponse{}
+			for _, v := range mdmArtifactVersionConfigValidators() {
+				v.ValidateResource(ctx, req, resp)
+			}
+			var got []string
+			for _, d := range resp.Diagnostics.Errors() {
+				got = append(got, d.Detail())
+			}
+			if len(got) != len(c.errors) {
+				t.Fatalf("expected %d error(s), got %q", len(c.errors), got)
+			}
+			for _, expected := range c.errors {
+				found := false
+				for _, detail := range got {
+					if strings.HasPrefix(detail, expected) {
+						found = true
+						break
+					}
+				}
+				if !found {
+					t.Errorf("missing error %q in %q", expected, got)
+				}
+			}
+		})
+	}
+}
+
+func TestCompareMDMArtifactVersionOSVersions(t *testing.T) {
+	cases := []struct {
+		a, b     string
+		expected int
+	}{
+		{"13", "13.0.0", 0},
+		{"13.3.1", "13.10", -1},
+		{"14", "13.99", 1},
+	}
+	for _, c := range cases {
+		a, err := parseMDMArtifactVersionOSVersion(c.a)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		b, err := parseMDMArtifactVersionOSVersion(c.b)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if got := compareMDMArtifactVersionOSVersions(a, b); got != c.expected {
+			t.Errorf("compare(%s, %s): expected %d, got %d", c.a, c.b, c.expected, got)
+		}
+	}
+}
@@ -1,6 +1,11 @@
 package provider
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/zentralopensource/goztl"
@@ -112,3 +117,19 @@ func mdmBlueprintArtifactRequestWithState(data mdmBlueprintArtifact) *goztl.MDMB
 		TagShards:        tagShards,
 	}
 }
+
+// listMDMBlueprintArtifacts returns the artifacts of a blueprint. The list
+// is filtered by the API, and again on the client side.
+func listMDMBlueprintArtifacts(ctx context.Context, c *goztl.Client, blueprintID int) ([]goztl.MDMBlueprintArtifact, error) {
+	req, err := c.NewRequest(ctx, http.MethodGet, fmt.Sprintf("mdm/blueprint_artifacts/?blueprint_id=%d", blueprintID), nil)
+	if err != nil {
+		return nil, err
+	}
+	var mbas []goztl.MDMBlueprintArtifact
+	if _, err := c.Do(ctx, req, &mbas); err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(mbas, func(mba goztl.MDMBlueprintArtifact) bool {
+		return mba.BlueprintID != blueprintID
+	}), nil
+}
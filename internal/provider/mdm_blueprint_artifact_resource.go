@@ -21,6 +21,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &MDMBlueprintArtifactResource{}
 var _ resource.ResourceWithImportState = &MDMBlueprintArtifactResource{}
+var _ resource.ResourceWithConfigValidators = &MDMBlueprintArtifactResource{}
 
 func NewMDMBlueprintArtifactResource() resource.Resource {
 	return &MDMBlueprintArtifactResource{}
@@ -190,6 +191,10 @@ func (r *MDMBlueprintArtifactResource) Schema(ctx context.Context, req resource.
 	}
 }
 
+func (r *MDMBlueprintArtifactResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return mdmArtifactVersionConfigValidators()
+}
+
 func (r *MDMBlueprintArtifactResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -245,8 +250,13 @@ func (r *MDMBlueprintArtifactResource) Read(ctx context.Context, req resource.Re
 		return
 	}
 
-	ztlMBA, _, err := r.client.MDMBlueprintArtifacts.GetByID(ctx, int(data.ID.ValueInt64()))
+	ztlMBA, ztlResp, err := r.client.MDMBlueprintArtifacts.GetByID(ctx, int(data.ID.ValueInt64()))
 	if err != nil {
+		if isNotFound(ztlResp, err) {
+			tflog.Warn(ctx, "MDM blueprint artifact not found, removing it from the state", map[string]interface{}{"id": data.ID.ValueInt64()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Client error",
 			fmt.Sprintf("Unable to read MDM blueprint artifact %d, got error: %s", int(data.ID.ValueInt64()), err),
@@ -308,5 +318,20 @@ func (r *MDMBlueprintArtifactResource) Delete(ctx context.Context, req resource.
 }
 
 func (r *MDMBlueprintArtifactResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resourceImportStatePassthroughZentralID(ctx, "MDM blueprint artifact", req, resp)
+	resourceImportStateZentralIDOrKey(ctx, "MDM blueprint artifact", "<blueprint_id>/<artifact_id>", req, resp, func(ctx context.Context, key []string) (int, error) {
+		blueprintID, err := parseImportKeyID("blueprint_id", key[0])
+		if err != nil {
+			return 0, err
+		}
+		mbas, err := listMDMBlueprintArtifacts(ctx, r.client, blueprintID)
+		if err != nil {
+			return 0, err
+		}
+		for _, mba := range mbas {
+			if mba.ArtifactID == key[1] {
+				return mba.ID, nil
+			}
+		}
+		return 0, errImportKeyNotFound
+	})
 }
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -19,6 +20,19 @@ func TestAccMDMBlueprintArtifactResource(t *testing.T) {
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
+			// Invalid OS versions and shards
+			{
+				Config:      testAccMDMBlueprintArtifactResourceConfigInvalid(name),
+				ExpectError: regexp.MustCompile(`The iOS min version cannot be set if ios is not enabled`),
+			},
+			{
+				Config:      testAccMDMBlueprintArtifactResourceConfigInvalid(name),
+				ExpectError: regexp.MustCompile(`The macOS min version must be below the macOS max version`),
+			},
+			{
+				Config:      testAccMDMBlueprintArtifactResourceConfigInvalid(name),
+				ExpectError: regexp.MustCompile(`The default shard 6 must be less than or equal to the shard modulo 5`),
+			},
 			// Create and Read
 			{
 				Config: testAccMDMBlueprintArtifactResourceConfigBare(name),
@@ -124,6 +138,13 @@ func TestAccMDMBlueprintArtifactResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// ImportState by natural key
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportStateIDFunc(resourceName, "blueprint_id", "artifact_id"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -149,6 +170,32 @@ resource "zentral_mdm_blueprint_artifact" "test" {
 `, name)
 }
 
+func testAccMDMBlueprintArtifactResourceConfigInvalid(name string) string {
+	return fmt.Sprintf(`
+resource "zentral_mdm_blueprint" "test" {
+  name = %[1]q
+}
+
+resource "zentral_mdm_artifact" "test" {
+  name      = %[1]q
+  type      = "Profile"
+  channel   = "Device"
+  platforms = ["macOS"]
+}
+
+resource "zentral_mdm_blueprint_artifact" "test" {
+  blueprint_id      = zentral_mdm_blueprint.test.id
+  artifact_id       = zentral_mdm_artifact.test.id
+  ios_min_version   = "17"
+  macos             = true
+  macos_min_version = "14.1"
+  macos_max_version = "14"
+  shard_modulo      = 5
+  default_shard     = 6
+}
+`, name)
+}
+
 func testAccMDMBlueprintArtifactResourceConfigFull(name string) string {
 	return fmt.Sprintf(`
 resource "zentral_mdm_blueprint" "test" {
@@ -0,0 +1,370 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zentralopensource/goztl"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &MDMBlueprintResolutionDataSource{}
+
+func NewMDMBlueprintResolutionDataSource() datasource.DataSource {
+	return &MDMBlueprintResolutionDataSource{}
+}
+
+// MDMBlueprintResolutionDataSource defines the data source implementation.
+type MDMBlueprintResolutionDataSource struct {
+	client *goztl.Client
+}
+
+type mdmBlueprintResolution struct {
+	BlueprintID  types.Int64  `tfsdk:"blueprint_id"`
+	Platform     types.String `tfsdk:"platform"`
+	OSVersion    types.String `tfsdk:"os_version"`
+	SerialNumber types.String `tfsdk:"serial_number"`
+	TagIDs       types.Set    `tfsdk:"tag_ids"`
+	Artifacts    types.List   `tfsdk:"artifacts"`
+}
+
+type mdmBlueprintResolutionArtifact struct {
+	ArtifactID   types.String `tfsdk:"artifact_id"`
+	ArtifactName types.String `tfsdk:"artifact_name"`
+	ArtifactType types.String `tfsdk:"artifact_type"`
+	Included     types.Bool   `tfsdk:"included"`
+	Reason       types.String `tfsdk:"reason"`
+	VersionID    types.String `tfsdk:"version_id"`
+	Version      types.Int64  `tfsdk:"version"`
+}
+
+var mdmBlueprintResolutionArtifactAttrTypes = map[string]attr.Type{
+	"artifact_id":   types.StringType,
+	"artifact_name": types.StringType,
+	"artifact_type": types.StringType,
+	"included":      types.BoolType,
+	"reason":        types.StringType,
+	"version_id":    types.StringType,
+	"version":       types.Int64Type,
+}
+
+// mdmArtifactVersionRef is an artifact version of any type.
+type mdmArtifactVersionRef struct {
+	ID      string
+	Version goztl.MDMArtifactVersion
+}
+
+func (d *MDMBlueprintResolutionDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mdm_blueprint_resolution"
+}
+
+func (d *MDMBlueprintResolutionDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Resolves the MDM artifacts and versions that a device would receive from a blueprint.",
+		MarkdownDescription: "The data source `zentral_mdm_blueprint_resolution` resolves the MDM artifacts and versions that a device would receive from a blueprint, with the reason why each artifact is included or excluded.",
+
+		Attributes: map[string]schema.Attribute{
+			"blueprint_id": schema.Int64Attribute{
+				Description:         "ID of the blueprint.",
+				MarkdownDescription: "`ID` of the blueprint.",
+				Required:            true,
+			},
+			"platform": schema.StringAttribute{
+				Description:         "Platform of the device. Valid values are iOS, iPadOS, macOS and tvOS.",
+				MarkdownDescription: "Platform of the device. Valid values are `iOS`, `iPadOS`, `macOS` and `tvOS`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"iOS", "iPadOS", "macOS", "tvOS"}...),
+				},
+			},
+			"os_version": schema.StringAttribute{
+				Description:         "OS version of the device, for example 15.7.1.",
+				MarkdownDescription: "OS version of the device, for example `15.7.1`.",
+				Required:            true,
+			},
+			"serial_number": schema.StringAttribute{
+				Description:         "Serial number of the device, used to compute its shards.",
+				MarkdownDescription: "Serial number of the device, used to compute its shards.",
+				Required:            true,
+			},
+			"tag_ids": schema.SetAttribute{
+				Description:         "IDs of the tags of the device.",
+				MarkdownDescription: "`IDs` of the tags of the device.",
+				ElementType:         types.Int64Type,
+				Optional:            true,
+			},
+			"artifacts": schema.ListNestedAttribute{
+				Description:         "List of the blueprint artifacts, sorted by name.",
+				MarkdownDescription: "List of the blueprint artifacts, sorted by name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"artifact_id": schema.StringAttribute{
+							Description:         "ID of the artifact.",
+							MarkdownDescription: "`ID` of the artifact.",
+							Computed:            true,
+						},
+						"artifact_name": schema.StringAttribute{
+							Description:         "Name of the artifact.",
+							MarkdownDescription: "Name of the artifact.",
+							Computed:            true,
+						},
+						"artifact_type": schema.StringAttribute{
+							Description:         "Type of the artifact.",
+							MarkdownDescription: "Type of the artifact.",
+							Computed:            true,
+						},
+						"included": schema.BoolAttribute{
+							Description:         "True if the device would receive the artifact.",
+							MarkdownDescription: "`true` if the device would receive the artifact.",
+							Computed:            true,
+						},
+						"reason": schema.StringAttribute{
+							Description:         "Reason why the artifact is included or excluded.",
+							MarkdownDescription: "Reason why the artifact is included or excluded.",
+							Computed:            true,
+						},
+						"version_id": schema.StringAttribute{
+							Description:         "ID of the artifact version that would be delivered, if any.",
+							MarkdownDescription: "`ID` of the artifact version that would be delivered, if any.",
+							Computed:            true,
+						},
+						"version": schema.Int64Attribute{
+							Description:         "Version of the artifact version that would be delivered, if any.",
+							MarkdownDescription: "Version of the artifact version that would be delivered, if any.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *MDMBlueprintResolutionDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*goztl.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *goztl.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *MDMBlueprintResolutionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data mdmBlueprintResolution
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	osVersion, err := parseMDMArtifactVersionOSVersion(data.OSVersion.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("os_version"), "Invalid OS version", err.Error())
+		return
+	}
+	device := mdmDevice{
+		Platform:     data.Platform.ValueString(),
+		OSVersion:    osVersion,
+		SerialNumber: data.SerialNumber.ValueString(),
+		TagIDs:       make(map[int]bool),
+	}
+	for _, tagID := range intListWithState(data.TagIDs) {
+		device.TagIDs[tagID] = true
+	}
+
+	mbas, err := listMDMBlueprintArtifacts(ctx, d.client, int(data.BlueprintID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to list MDM blueprint artifacts, got error: %s", err),
+		)
+		return
+	}
+
+	resolvedArtifacts := make([]mdmBlueprintResolutionArtifact, 0)
+	for i := range mbas {
+		mba := &mbas[i]
+		ma, r, err := d.client.MDMArtifacts.GetByID(ctx, mba.ArtifactID)
+		if isNotFound(r, err) {
+			// artifact deleted since the blueprint artifacts were listed
+			ma = nil
+		} else if err != nil {
+			resp.Diagnostics.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to read MDM artifact %s, got error: %s", mba.ArtifactID, err),
+			)
+			return
+		}
+		var versions []mdmArtifactVersionRef
+		if ma != nil {
+			versions, err = listMDMArtifactVersions(ctx, d.client, ma)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Client Error",
+					fmt.Sprintf("Unable to list MDM artifact %s versions, got error: %s", ma.ID, err),
+				)
+				return
+			}
+		}
+		resolvedArtifacts = append(
+			resolvedArtifacts,
+			resolveMDMBlueprintArtifact(mba, ma, versions, device),
+		)
+	}
+	sort.SliceStable(resolvedArtifacts, func(i, j int) bool {
+		return resolvedArtifacts[i].ArtifactName.ValueString() < resolvedArtifacts[j].ArtifactName.ValueString()
+	})
+
+	artifactsForState, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: mdmBlueprintResolutionArtifactAttrTypes}, resolvedArtifacts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Artifacts = artifactsForState
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// resolveMDMBlueprintArtifact returns the artifact version of a blueprint
+// artifact that the device would receive, if any. Like in Zentral, the
+// blueprint artifact shard is computed using the artifact ID, the artifact
+// version shards using the artifact version IDs, and the highest version in
+// scope is selected.
+func resolveMDMBlueprintArtifact(mba *goztl.MDMBlueprintArtifact, ma *goztl.MDMArtifact, versions []mdmArtifactVersionRef, device mdmDevice) mdmBlueprintResolutionArtifact {
+	ra := mdmBlueprintResolutionArtifact{
+		ArtifactID:   types.StringValue(mba.ArtifactID),
+		ArtifactName: types.StringNull(),
+		ArtifactType: types.StringNull(),
+		Included:     types.BoolValue(false),
+		VersionID:    types.StringNull(),
+		Version:      types.Int64Null(),
+	}
+	if ma == nil {
+		ra.Reason = types.StringValue("artifact not found")
+		return ra
+	}
+	ra.ArtifactName = types.StringValue(ma.Name)
+	ra.ArtifactType = types.StringValue(ma.Type)
+
+	platformOK := false
+	for _, p := range ma.Platforms {
+		if p == device.Platform {
+			platformOK = true
+			break
+		}
+	}
+	if !platformOK {
+		ra.Reason = types.StringValue(fmt.Sprintf("artifact not available on %s", device.Platform))
+		return ra
+	}
+
+	if ok, reason := mdmArtifactScopeWithBlueprintArtifact(mba).resolve(device, mba.ArtifactID); !ok {
+		ra.Reason = types.StringValue(fmt.Sprintf("blueprint artifact: %s", reason))
+		return ra
+	}
+
+	if len(versions) == 0 {
+		ra.Reason = types.StringValue("no artifact versions")
+		return ra
+	}
+	sort.SliceStable(versions, func(i, j int) bool {
+		return versions[i].Version.Version > versions[j].Version.Version
+	})
+	var exclusions []string
+	for _, v := range versions {
+		ok, reason := mdmArtifactScopeWithVersion(v.Version).resolve(device, v.ID)
+		if ok {
+			ra.Included = types.BoolValue(true)
+			ra.Reason = types.StringValue(fmt.Sprintf("version %d: %s", v.Version.Version, reason))
+			ra.VersionID = types.StringValue(v.ID)
+			ra.Version = types.Int64Value(int64(v.Version.Version))
+			return ra
+		}
+		exclusions = append(exclusions, fmt.Sprintf("version %d: %s", v.Version.Version, reason))
+	}
+	ra.Reason = types.StringValue(fmt.Sprintf("no artifact versions in scope (%s)", strings.Join(exclusions, "; ")))
+	return ra
+}
+
+// listMDMArtifactVersions returns the versions of an artifact, using the list
+// endpoint of its type, filtered by artifact ID.
+func listMDMArtifactVersions(ctx context.Context, c *goztl.Client, ma *goztl.MDMArtifact) ([]mdmArtifactVersionRef, error) {
+	switch ma.Type {
+	case "Profile":
+		return listMDMArtifactVersionsOf(ctx, c, "mdm/profiles/", ma.ID, func(v *goztl.MDMProfile) mdmArtifactVersionRef {
+			return mdmArtifactVersionRef{v.ID, v.MDMArtifactVersion}
+		})
+	case "Activation", "Asset", "Configuration":
+		return listMDMArtifactVersionsOf(ctx, c, "mdm/declarations/", ma.ID, func(v *goztl.MDMDeclaration) mdmArtifactVersionRef {
+			return mdmArtifactVersionRef{v.ID, v.MDMArtifactVersion}
+		})
+	case "Store App":
+		return listMDMArtifactVersionsOf(ctx, c, "mdm/store_apps/", ma.ID, func(v *goztl.MDMStoreApp) mdmArtifactVersionRef {
+			return mdmArtifactVersionRef{v.ID, v.MDMArtifactVersion}
+		})
+	case "Enterprise App":
+		return listMDMArtifactVersionsOf(ctx, c, "mdm/enterprise_apps/", ma.ID, func(v *goztl.MDMEnterpriseApp) mdmArtifactVersionRef {
+			return mdmArtifactVersionRef{v.ID, v.MDMArtifactVersion}
+		})
+	case "Data Asset":
+		return listMDMArtifactVersionsOf(ctx, c, "mdm/data_assets/", ma.ID, func(v *goztl.MDMDataAsset) mdmArtifactVersionRef {
+			return mdmArtifactVersionRef{v.ID, v.MDMArtifactVersion}
+		})
+	case "Certificate Asset":
+		return listMDMArtifactVersionsOf(ctx, c, "mdm/cert_assets/", ma.ID, func(v *goztl.MDMCertAsset) mdmArtifactVersionRef {
+			return mdmArtifactVersionRef{v.ID, v.MDMArtifactVersion}
+		})
+	case "Provisioning Profile":
+		return listMDMArtifactVersionsOf(ctx, c, "mdm/provisioning_profiles/", ma.ID, func(v *goztl.MDMProvisioningProfile) mdmArtifactVersionRef {
+			return mdmArtifactVersionRef{v.ID, v.MDMArtifactVersion}
+		})
+	default:
+		return nil, nil
+	}
+}
+
+// listMDMArtifactVersionsOf returns the versions of an artifact listed by an
+// artifact version endpoint. The list is filtered by the API, and again on
+// the client side.
+func listMDMArtifactVersionsOf[T any](ctx context.Context, c *goztl.Client, path string, artifactID string, ref func(*T) mdmArtifactVersionRef) ([]mdmArtifactVersionRef, error) {
+	req, err := c.NewRequest(ctx, http.MethodGet, fmt.Sprintf("%s?artifact_id=%s", path, url.QueryEscape(artifactID)), nil)
+	if err != nil {
+		return nil, err
+	}
+	var objs []T
+	if _, err := c.Do(ctx, req, &objs); err != nil {
+		return nil, err
+	}
+	versions := make([]mdmArtifactVersionRef, 0, len(objs))
+	for i := range objs {
+		if v := ref(&objs[i]); v.Version.ArtifactID == artifactID {
+			versions = append(versions, v)
+		}
+	}
+	return versions, nil
+}
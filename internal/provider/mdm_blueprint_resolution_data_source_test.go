@@ -0,0 +1,230 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/zentralopensource/goztl"
+)
+
+func TestAccMDMBlueprintResolutionDataSource(t *testing.T) {
+	name := acctest.RandString(12)
+	aResourceName := "zentral_mdm_artifact.test"
+	bResourceName := "zentral_mdm_artifact.test_b"
+	d1ResourceName := "zentral_mdm_declaration.v1"
+	d2ResourceName := "zentral_mdm_declaration.v2"
+	ds14ResourceName := "data.zentral_mdm_blueprint_resolution.macos14"
+	ds15ResourceName := "data.zentral_mdm_blueprint_resolution.macos15"
+	dsTaggedResourceName := "data.zentral_mdm_blueprint_resolution.tagged"
+	dsIOSResourceName := "data.zentral_mdm_blueprint_resolution.ios"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMDMBlueprintResolutionDataSourceInvalidConfig(),
+				ExpectError: regexp.MustCompile(`Invalid OS version`),
+			},
+			{
+				Config: testAccMDMBlueprintResolutionDataSourceConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// macOS 14, only the first version is in scope
+					resource.TestCheckResourceAttr(
+						ds14ResourceName, "artifacts.#", "2"),
+					resource.TestCheckResourceAttrPair(
+						ds14ResourceName, "artifacts.0.artifact_id", aResourceName, "id"),
+					resource.TestCheckResourceAttr(
+						ds14ResourceName, "artifacts.0.artifact_name", name+"a"),
+					resource.TestCheckResourceAttr(
+						ds14ResourceName, "artifacts.0.artifact_type", "Configuration"),
+					resource.TestCheckResourceAttr(
+						ds14ResourceName, "artifacts.0.included", "true"),
+					resource.TestCheckResourceAttr(
+						ds14ResourceName, "artifacts.0.reason", "version 1: shard 100/100 includes all the devices"),
+					resource.TestCheckResourceAttrPair(
+						ds14ResourceName, "artifacts.0.version_id", d1ResourceName, "id"),
+					resource.TestCheckResourceAttr(
+						ds14ResourceName, "artifacts.0.version", "1"),
+					resource.TestCheckResourceAttrPair(
+						ds14ResourceName, "artifacts.1.artifact_id", bResourceName, "id"),
+					resource.TestCheckResourceAttr(
+						ds14ResourceName, "artifacts.1.included", "false"),
+					resource.TestCheckResourceAttr(
+						ds14ResourceName, "artifacts.1.reason", "no artifact versions"),
+					resource.TestCheckNoResourceAttr(
+						ds14ResourceName, "artifacts.1.version_id"),
+					resource.TestCheckNoResourceAttr(
+						ds14ResourceName, "artifacts.1.version"),
+					// macOS 15, the highest version in scope is selected
+					resource.TestCheckResourceAttr(
+						ds15ResourceName, "artifacts.0.included", "true"),
+					resource.TestCheckResourceAttrPair(
+						ds15ResourceName, "artifacts.0.version_id", d2ResourceName, "id"),
+					resource.TestCheckResourceAttr(
+						ds15ResourceName, "artifacts.0.version", "2"),
+					// excluded tag
+					resource.TestCheckResourceAttr(
+						dsTaggedResourceName, "artifacts.0.included", "false"),
+					resource.TestMatchResourceAttr(
+						dsTaggedResourceName, "artifacts.0.reason", regexp.MustCompile(`^blueprint artifact: excluded tag \d+$`)),
+					// platform not available
+					resource.TestCheckResourceAttr(
+						dsIOSResourceName, "artifacts.0.included", "false"),
+					resource.TestCheckResourceAttr(
+						dsIOSResourceName, "artifacts.0.reason", "artifact not available on iOS"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMDMBlueprintResolutionDataSourceInvalidConfig() string {
+	return `
+data "zentral_mdm_blueprint_resolution" "test" {
+  blueprint_id  = 1
+  platform      = "macOS"
+  os_version    = "fourteen"
+  serial_number = "0123456789"
+}
+`
+}
+
+func testAccMDMBlueprintResolutionDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "zentral_tag" "test" {
+  name = %[1]q
+}
+
+resource "zentral_mdm_blueprint" "test" {
+  name = %[1]q
+}
+
+resource "zentral_mdm_artifact" "test" {
+  name      = "%[1]sa"
+  type      = "Configuration"
+  channel   = "Device"
+  platforms = ["macOS"]
+}
+
+resource "zentral_mdm_artifact" "test_b" {
+  name      = "%[1]sb"
+  type      = "Configuration"
+  channel   = "Device"
+  platforms = ["macOS"]
+}
+
+resource "zentral_mdm_blueprint_artifact" "test" {
+  blueprint_id     = zentral_mdm_blueprint.test.id
+  artifact_id      = zentral_mdm_artifact.test.id
+  macos            = true
+  excluded_tag_ids = [zentral_tag.test.id]
+}
+
+resource "zentral_mdm_blueprint_artifact" "test_b" {
+  blueprint_id = zentral_mdm_blueprint.test.id
+  artifact_id  = zentral_mdm_artifact.test_b.id
+  macos        = true
+}
+
+resource "zentral_mdm_declaration" "v1" {
+  artifact_id = zentral_mdm_artifact.test.id
+  source = jsonencode({
+    Type       = "com.apple.configuration.passcode.settings"
+    Identifier = "com.example.%[1]s"
+    Payload = {
+      MinimumLength = 10
+    }
+  })
+  version = 1
+  macos   = true
+}
+
+resource "zentral_mdm_declaration" "v2" {
+  artifact_id = zentral_mdm_artifact.test.id
+  source = jsonencode({
+    Type       = "com.apple.configuration.passcode.settings"
+    Identifier = "com.example.%[1]s"
+    Payload = {
+      MinimumLength = 12
+    }
+  })
+  version           = 2
+  macos             = true
+  macos_min_version = "15"
+}
+
+data "zentral_mdm_blueprint_resolution" "macos14" {
+  blueprint_id  = zentral_mdm_blueprint.test.id
+  platform      = "macOS"
+  os_version    = "14.5"
+  serial_number = "0123456789"
+
+  depends_on = [
+    zentral_mdm_blueprint_artifact.test,
+    zentral_mdm_blueprint_artifact.test_b,
+    zentral_mdm_declaration.v1,
+    zentral_mdm_declaration.v2,
+  ]
+}
+
+data "zentral_mdm_blueprint_resolution" "macos15" {
+  blueprint_id  = zentral_mdm_blueprint.test.id
+  platform      = "macOS"
+  os_version    = "15.1"
+  serial_number = "0123456789"
+
+  depends_on = [
+    zentral_mdm_blueprint_artifact.test,
+    zentral_mdm_blueprint_artifact.test_b,
+    zentral_mdm_declaration.v1,
+    zentral_mdm_declaration.v2,
+  ]
+}
+
+data "zentral_mdm_blueprint_resolution" "tagged" {
+  blueprint_id  = zentral_mdm_blueprint.test.id
+  platform      = "macOS"
+  os_version    = "15.1"
+  serial_number = "0123456789"
+  tag_ids       = [zentral_tag.test.id]
+
+  depends_on = [
+    zentral_mdm_blueprint_artifact.test,
+    zentral_mdm_blueprint_artifact.test_b,
+    zentral_mdm_declaration.v1,
+    zentral_mdm_declaration.v2,
+  ]
+}
+
+data "zentral_mdm_blueprint_resolution" "ios" {
+  blueprint_id  = zentral_mdm_blueprint.test.id
+  platform      = "iOS"
+  os_version    = "18"
+  serial_number = "0123456789"
+
+  depends_on = [
+    zentral_mdm_blueprint_artifact.test,
+    zentral_mdm_blueprint_artifact.test_b,
+    zentral_mdm_declaration.v1,
+    zentral_mdm_declaration.v2,
+  ]
+}
+`, name)
+}
+
+func TestResolveMDMBlueprintArtifactNotFound(t *testing.T) {
+	ra := resolveMDMBlueprintArtifact(&goztl.MDMBlueprintArtifact{ArtifactID: "yolo"}, nil, nil, mdmDevice{Platform: "macOS"})
+	if ra.ArtifactID.ValueString() != "yolo" {
+		t.Errorf("unexpected artifact ID %s", ra.ArtifactID)
+	}
+	if ra.Included.ValueBool() {
+		t.Error("artifact not found included")
+	}
+	if ra.Reason.ValueString() != "artifact not found" {
+		t.Errorf("unexpected reason %s", ra.Reason)
+	}
+}
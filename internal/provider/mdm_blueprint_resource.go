@@ -183,8 +183,13 @@ func (r *MDMBlueprintResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
-	ztlMB, _, err := r.client.MDMBlueprints.GetByID(ctx, int(data.ID.ValueInt64()))
+	ztlMB, ztlResp, err := r.client.MDMBlueprints.GetByID(ctx, int(data.ID.ValueInt64()))
 	if err != nil {
+		if isNotFound(ztlResp, err) {
+			tflog.Warn(ctx, "MDM blueprint not found, removing it from the state", map[string]interface{}{"id": data.ID.ValueInt64()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Client error",
 			fmt.Sprintf("Unable to read MDM blueprint %d, got error: %s", data.ID.ValueInt64(), err),
@@ -246,5 +251,14 @@ func (r *MDMBlueprintResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *MDMBlueprintResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resourceImportStatePassthroughZentralID(ctx, "MDM blueprint", req, resp)
+	resourceImportStateZentralIDOrName(ctx, "MDM blueprint", req, resp, func(ctx context.Context, name string) (int, error) {
+		obj, _, err := r.client.MDMBlueprints.GetByName(ctx, name)
+		if err != nil {
+			return 0, err
+		}
+		if obj == nil {
+			return 0, errImportKeyNotFound
+		}
+		return obj.ID, nil
+	})
 }
@@ -24,6 +24,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &MDMCertAssetResource{}
 var _ resource.ResourceWithImportState = &MDMCertAssetResource{}
+var _ resource.ResourceWithConfigValidators = &MDMCertAssetResource{}
 
 func NewMDMCertAssetResource() resource.Resource {
 	return &MDMCertAssetResource{}
@@ -263,6 +264,10 @@ func (r *MDMCertAssetResource) Schema(ctx context.Context, req resource.SchemaRe
 	}
 }
 
+func (r *MDMCertAssetResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return mdmArtifactVersionConfigValidators()
+}
+
 func (r *MDMCertAssetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -318,8 +323,13 @@ func (r *MDMCertAssetResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
-	ztlMCA, _, err := r.client.MDMCertAssets.GetByID(ctx, data.ID.ValueString())
+	ztlMCA, ztlResp, err := r.client.MDMCertAssets.GetByID(ctx, data.ID.ValueString())
 	if err != nil {
+		if isNotFound(ztlResp, err) {
+			tflog.Warn(ctx, "MDM cert asset not found, removing it from the state", map[string]interface{}{"id": data.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Client error",
 			fmt.Sprintf("Unable to read MDM cert asset %s, got error: %s", data.ID.ValueString(), err),
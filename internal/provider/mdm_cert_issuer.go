@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	dataschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
@@ -138,7 +139,7 @@ func makeDigicertBackendResourceAttribute() schema.SingleNestedAttribute {
 	return schema.SingleNestedAttribute{
 		Description:         desc,
 		MarkdownDescription: desc,
-		Attributes: map[string]schema.Attribute{
+		Attributes: withWriteOnlySecretAttributes(map[string]schema.Attribute{
 			"api_base_url": schema.StringAttribute{
 				Description:         "API base URL. Defaults to https://one.digicert.com/mpki/api/.",
 				MarkdownDescription: "API base URL. Defaults to `https://one.digicert.com/mpki/api/`.",
@@ -147,10 +148,13 @@ func makeDigicertBackendResourceAttribute() schema.SingleNestedAttribute {
 				Default:             stringdefault.StaticString(tfDigicertDefaultAPIBaseURL),
 			},
 			"api_token": schema.StringAttribute{
-				Description:         "API token.",
-				MarkdownDescription: "API token.",
+				Description:         "API token. Exactly one of api_token or api_token_wo must be set.",
+				MarkdownDescription: "API token. Exactly one of `api_token` or `api_token_wo` must be set.",
 				Sensitive:           true,
-				Required:            true,
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("api_token_wo")),
+				},
 			},
 			"profile_guid": schema.StringAttribute{
 				Description:         "Profile GUID.",
@@ -197,7 +201,9 @@ func makeDigicertBackendResourceAttribute() schema.SingleNestedAttribute {
 				MarkdownDescription: "Default seat email.",
 				Required:            true,
 			},
-		},
+		}, map[string]string{
+			"api_token": "API token.",
+		}),
 		Optional: true,
 	}
 }
@@ -21,6 +21,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &MDMDataAssetResource{}
 var _ resource.ResourceWithImportState = &MDMDataAssetResource{}
+var _ resource.ResourceWithConfigValidators = &MDMDataAssetResource{}
 
 func NewMDMDataAssetResource() resource.Resource {
 	return &MDMDataAssetResource{}
@@ -215,6 +216,10 @@ func (r *MDMDataAssetResource) Schema(ctx context.Context, req resource.SchemaRe
 	}
 }
 
+func (r *MDMDataAssetResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return mdmArtifactVersionConfigValidators()
+}
+
 func (r *MDMDataAssetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -270,8 +275,13 @@ func (r *MDMDataAssetResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
-	ztlMDA, _, err := r.client.MDMDataAssets.GetByID(ctx, data.ID.ValueString())
+	ztlMDA, ztlResp, err := r.client.MDMDataAssets.GetByID(ctx, data.ID.ValueString())
 	if err != nil {
+		if isNotFound(ztlResp, err) {
+			tflog.Warn(ctx, "MDM data asset not found, removing it from the state", map[string]interface{}{"id": data.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Client error",
 			fmt.Sprintf("Unable to read MDM data asset %s, got error: %s", data.ID.ValueString(), err),
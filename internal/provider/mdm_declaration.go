@@ -11,26 +11,28 @@ import (
 )
 
 type mdmDeclaration struct {
-	ID               types.String `tfsdk:"id"`
-	Source           types.String `tfsdk:"source"`
-	ArtifactID       types.String `tfsdk:"artifact_id"`
-	IOS              types.Bool   `tfsdk:"ios"`
-	IOSMaxVersion    types.String `tfsdk:"ios_max_version"`
-	IOSMinVersion    types.String `tfsdk:"ios_min_version"`
-	IPadOS           types.Bool   `tfsdk:"ipados"`
-	IPadOSMaxVersion types.String `tfsdk:"ipados_max_version"`
-	IPadOSMinVersion types.String `tfsdk:"ipados_min_version"`
-	MacOS            types.Bool   `tfsdk:"macos"`
-	MacOSMaxVersion  types.String `tfsdk:"macos_max_version"`
-	MacOSMinVersion  types.String `tfsdk:"macos_min_version"`
-	TVOS             types.Bool   `tfsdk:"tvos"`
-	TVOSMaxVersion   types.String `tfsdk:"tvos_max_version"`
-	TVOSMinVersion   types.String `tfsdk:"tvos_min_version"`
-	DefaultShard     types.Int64  `tfsdk:"default_shard"`
-	ShardModulo      types.Int64  `tfsdk:"shard_modulo"`
-	ExcludedTagIDs   types.Set    `tfsdk:"excluded_tag_ids"`
-	TagShards        types.Set    `tfsdk:"tag_shards"`
-	Version          types.Int64  `tfsdk:"version"`
+	ID               types.String    `tfsdk:"id"`
+	Source           jsonStringValue `tfsdk:"source"`
+	Identifier       types.String    `tfsdk:"identifier"`
+	ServerToken      types.String    `tfsdk:"server_token"`
+	ArtifactID       types.String    `tfsdk:"artifact_id"`
+	IOS              types.Bool      `tfsdk:"ios"`
+	IOSMaxVersion    types.String    `tfsdk:"ios_max_version"`
+	IOSMinVersion    types.String    `tfsdk:"ios_min_version"`
+	IPadOS           types.Bool      `tfsdk:"ipados"`
+	IPadOSMaxVersion types.String    `tfsdk:"ipados_max_version"`
+	IPadOSMinVersion types.String    `tfsdk:"ipados_min_version"`
+	MacOS            types.Bool      `tfsdk:"macos"`
+	MacOSMaxVersion  types.String    `tfsdk:"macos_max_version"`
+	MacOSMinVersion  types.String    `tfsdk:"macos_min_version"`
+	TVOS             types.Bool      `tfsdk:"tvos"`
+	TVOSMaxVersion   types.String    `tfsdk:"tvos_max_version"`
+	TVOSMinVersion   types.String    `tfsdk:"tvos_min_version"`
+	DefaultShard     types.Int64     `tfsdk:"default_shard"`
+	ShardModulo      types.Int64     `tfsdk:"shard_modulo"`
+	ExcludedTagIDs   types.Set       `tfsdk:"excluded_tag_ids"`
+	TagShards        types.Set       `tfsdk:"tag_shards"`
+	Version          types.Int64     `tfsdk:"version"`
 }
 
 // source serialization / deserialization
@@ -64,7 +66,9 @@ func mdmDeclarationForState(mda *goztl.MDMDeclaration) (mdmDeclaration, error) {
 
 	return mdmDeclaration{
 		ID:               types.StringValue(mda.ID),
-		Source:           types.StringValue(source),
+		Source:           newJSONStringValue(source),
+		Identifier:       mdmDeclarationSourceAttr(source, "Identifier"),
+		ServerToken:      mdmDeclarationSourceAttr(source, "ServerToken"),
 		ArtifactID:       types.StringValue(mda.ArtifactID),
 		IOS:              types.BoolValue(mda.IOS),
 		IOSMaxVersion:    types.StringValue(mda.IOSMaxVersion),
@@ -138,14 +142,28 @@ func (v mdmDeclarationSourceValidator) ValidateString(ctx context.Context, reque
 
 	value := request.ConfigValue
 
-	_, err := deserializeMDMDeclarationSource(value.ValueString())
-	if err == nil {
+	if _, err := deserializeMDMDeclarationSource(value.ValueString()); err != nil {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueMatchDiagnostic(
+			request.Path,
+			v.Description(ctx),
+			value.String(),
+		))
 		return
 	}
 
-	response.Diagnostics.Append(validatordiag.InvalidAttributeValueMatchDiagnostic(
-		request.Path,
-		v.Description(ctx),
-		value.String(),
-	))
+	errs, warnings := validateMDMDeclarationSource(value.ValueString())
+	for _, problem := range errs {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid DDM declaration",
+			problem,
+		)
+	}
+	for _, problem := range warnings {
+		response.Diagnostics.AddAttributeWarning(
+			request.Path,
+			"Unverified DDM declaration",
+			problem+"\n\nThe catalogue of the declaration types of this provider version might be outdated.",
+		)
+	}
 }
@@ -22,6 +22,8 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &MDMDeclarationResource{}
 var _ resource.ResourceWithImportState = &MDMDeclarationResource{}
+var _ resource.ResourceWithConfigValidators = &MDMDeclarationResource{}
+var _ resource.ResourceWithModifyPlan = &MDMDeclarationResource{}
 
 func NewMDMDeclarationResource() resource.Resource {
 	return &MDMDeclarationResource{}
@@ -56,11 +58,22 @@ func (r *MDMDeclarationResource) Schema(ctx context.Context, req resource.Schema
 				Required:            true,
 			},
 			"source": schema.StringAttribute{
-				Description:         "The actual DDM declaration (JSON).",
-				MarkdownDescription: "The actual DDM declaration (JSON).",
+				Description:         "The actual DDM declaration (JSON). The declaration type and payload keys are verified during the plan. Key order and whitespace changes are ignored.",
+				MarkdownDescription: "The actual DDM declaration (JSON). The declaration `Type` and `Payload` keys are verified during the plan. Key order and whitespace changes are ignored.",
+				CustomType:          jsonStringType{},
 				Required:            true,
 				Validators:          []validator.String{mdmDeclarationSourceValidator{}},
 			},
+			"identifier": schema.StringAttribute{
+				Description:         "The Identifier of the declaration.",
+				MarkdownDescription: "The `Identifier` of the declaration.",
+				Computed:            true,
+			},
+			"server_token": schema.StringAttribute{
+				Description:         "The ServerToken of the declaration.",
+				MarkdownDescription: "The `ServerToken` of the declaration.",
+				Computed:            true,
+			},
 			"ios": schema.BoolAttribute{
 				Description:         "Toggles the installation of the declaration on iOS devices.",
 				MarkdownDescription: "Toggles the installation of the declaration on iOS devices.",
@@ -197,6 +210,10 @@ func (r *MDMDeclarationResource) Schema(ctx context.Context, req resource.Schema
 	}
 }
 
+func (r *MDMDeclarationResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return mdmArtifactVersionConfigValidators()
+}
+
 func (r *MDMDeclarationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -269,8 +286,13 @@ func (r *MDMDeclarationResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	ztlMD, _, err := r.client.MDMDeclarations.GetByID(ctx, data.ID.ValueString())
+	ztlMD, ztlResp, err := r.client.MDMDeclarations.GetByID(ctx, data.ID.ValueString())
 	if err != nil {
+		if isNotFound(ztlResp, err) {
+			tflog.Warn(ctx, "MDM declaration not found, removing it from the state", map[string]interface{}{"id": data.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Client error",
 			fmt.Sprintf("Unable to read MDM declaration %s, got error: %s", data.ID.ValueString(), err),
@@ -334,6 +356,30 @@ func (r *MDMDeclarationResource) Update(ctx context.Context, req resource.Update
 	resp.Diagnostics.Append(resp.State.Set(ctx, ztlMDFS)...)
 }
 
+// ModifyPlan sets the identifier and the server token using the planned source.
+func (r *MDMDeclarationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan mdmDeclaration
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Source.IsUnknown() {
+		plan.Identifier = types.StringUnknown()
+		plan.ServerToken = types.StringUnknown()
+	} else {
+		plan.Identifier = mdmDeclarationSourceAttr(plan.Source.ValueString(), "Identifier")
+		plan.ServerToken = mdmDeclarationSourceAttr(plan.Source.ValueString(), "ServerToken")
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+}
+
 func (r *MDMDeclarationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var data mdmDeclaration
 
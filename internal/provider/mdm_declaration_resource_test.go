@@ -2,6 +2,8 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -20,6 +22,11 @@ func TestAccMDMDeclarationResource(t *testing.T) {
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
+			// Invalid source
+			{
+				Config:      testAccMDMDeclarationResourceConfigInvalid(name, identifer),
+				ExpectError: regexp.MustCompile(`com.apple.configuration.passcode.settings payload key MinimumLength must\s+be of type integer`),
+			},
 			// Create and Read
 			{
 				Config: testAccMDMDeclarationResourceConfigBare(name, identifer, serverToken),
@@ -33,6 +40,10 @@ func TestAccMDMDeclarationResource(t *testing.T) {
 							identifer, serverToken,
 						),
 					),
+					resource.TestCheckResourceAttr(
+						resourceName, "identifier", identifer),
+					resource.TestCheckResourceAttr(
+						resourceName, "server_token", serverToken),
 					resource.TestCheckResourceAttr(
 						resourceName, "ios", "false"),
 					resource.TestCheckResourceAttr(
@@ -138,10 +149,53 @@ func TestAccMDMDeclarationResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// Same source, different formatting
+			// The configured source is kept in the state, and the plan
+			// following the apply is empty.
+			{
+				Config: testAccMDMDeclarationResourceConfigFullHeredoc(name, identifer, serverToken),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith(
+						resourceName, "source", func(value string) error {
+							if !strings.Contains(value, "\n") {
+								return fmt.Errorf("configured source not kept: %s", value)
+							}
+							return nil
+						}),
+					resource.TestCheckResourceAttr(
+						resourceName, "identifier", identifer),
+					resource.TestCheckResourceAttr(
+						resourceName, "version", "2"),
+				),
+			},
 		},
 	})
 }
 
+func testAccMDMDeclarationResourceConfigInvalid(name string, identifer string) string {
+	return fmt.Sprintf(`
+resource "zentral_mdm_artifact" "test" {
+  name      = %[1]q
+  type      = "Configuration"
+  channel   = "Device"
+  platforms = ["macOS"]
+}
+
+resource "zentral_mdm_declaration" "test" {
+  artifact_id = zentral_mdm_artifact.test.id
+  source = jsonencode({
+    Type       = "com.apple.configuration.passcode.settings"
+    Identifier = %[2]q
+    Payload = {
+      MinimumLength = "ten"
+    }
+  })
+  version = 1
+  macos   = true
+}
+`, name, identifer)
+}
+
 func testAccMDMDeclarationResourceConfigBare(name string, identifer string, serverToken string) string {
 	return fmt.Sprintf(`
 resource "zentral_mdm_artifact" "test" {
@@ -205,3 +259,44 @@ resource "zentral_mdm_declaration" "test" {
 }
 `, name, identifer, serverToken)
 }
+
+func testAccMDMDeclarationResourceConfigFullHeredoc(name string, identifer string, serverToken string) string {
+	return fmt.Sprintf(`
+resource "zentral_mdm_artifact" "test" {
+  name      = %[1]q
+  type      = "Configuration"
+  channel   = "Device"
+  platforms = ["macOS"]
+}
+
+resource "zentral_tag" "excluded" {
+  name = "%[1]s excluded"
+}
+
+resource "zentral_tag" "shard" {
+  name = "%[1]s shard"
+}
+
+resource "zentral_mdm_declaration" "test" {
+  artifact_id = zentral_mdm_artifact.test.id
+  source      = <<-EOT
+  {
+    "Type": "com.apple.configuration.passcode.settings",
+    "Identifier": %[2]q,
+    "ServerToken": %[3]q,
+    "Payload": {
+      "MinimumLength": 11,
+      "MaximumFailedAttempts": 3
+    }
+  }
+  EOT
+  version           = 2
+  macos             = true
+  macos_min_version = "13.3.1"
+  shard_modulo      = 5
+  default_shard     = 1
+  excluded_tag_ids  = [zentral_tag.excluded.id]
+  tag_shards        = [{ tag_id = zentral_tag.shard.id, shard = 4 }]
+}
+`, name, identifer, serverToken)
+}
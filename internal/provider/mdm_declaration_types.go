@@ -0,0 +1,221 @@
+package provider
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// mdmDeclarationTypesJSON is the catalogue of the Apple declaration types.
+// The payload keys of the types with a null payload are not verified.
+//
+//go:embed mdm_declaration_types.json
+var mdmDeclarationTypesJSON []byte
+
+type mdmDeclarationPayloadKey struct {
+	Type     string `json:"type"`
+	Required bool   `json:"required"`
+}
+
+type mdmDeclarationType struct {
+	Payload map[string]mdmDeclarationPayloadKey `json:"payload"`
+}
+
+var mdmDeclarationTypes = sync.OnceValue(func() map[string]mdmDeclarationType {
+	var dts map[string]mdmDeclarationType
+	if err := json.Unmarshal(mdmDeclarationTypesJSON, &dts); err != nil {
+		panic(fmt.Sprintf("invalid MDM declaration types catalogue: %s", err))
+	}
+	return dts
+})
+
+// unmarshalJSONObject decodes a JSON object, keeping the numbers as json.Number.
+func unmarshalJSONObject(src string) (map[string]interface{}, error) {
+	d := json.NewDecoder(strings.NewReader(src))
+	d.UseNumber()
+	var obj map[string]interface{}
+	if err := d.Decode(&obj); err != nil {
+		return nil, err
+	}
+	if obj == nil {
+		return nil, fmt.Errorf("not a JSON object")
+	}
+	if d.More() {
+		return nil, fmt.Errorf("unexpected data after the JSON object")
+	}
+	return obj, nil
+}
+
+// jsonSemanticallyEqual returns true if both JSON documents have the same
+// keys and values, regardless of the key order and of the whitespace.
+func jsonSemanticallyEqual(a string, b string) bool {
+	var av, bv interface{}
+	if err := json.Unmarshal([]byte(a), &av); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(b), &bv); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(av, bv)
+}
+
+// mdmDeclarationSourceAttr returns a top level string value of a declaration source.
+func mdmDeclarationSourceAttr(src string, key string) types.String {
+	obj, err := unmarshalJSONObject(src)
+	if err != nil {
+		return types.StringNull()
+	}
+	if v, ok := obj[key].(string); ok {
+		return types.StringValue(v)
+	}
+	return types.StringNull()
+}
+
+// validateMDMDeclarationSource verifies a declaration source using the
+// catalogue of the Apple declaration types, and returns the errors and the
+// warnings found. Apple adds declaration types and payload keys with each OS
+// release, so the ones missing from the catalogue are only reported as
+// warnings.
+func validateMDMDeclarationSource(src string) ([]string, []string) {
+	obj, err := unmarshalJSONObject(src)
+	if err != nil {
+		return []string{fmt.Sprintf("invalid JSON: %s", err)}, nil
+	}
+
+	var errors, warnings []string
+	for _, key := range sortedKeys(obj) {
+		switch key {
+		case "Type", "Identifier", "ServerToken":
+			if _, ok := obj[key].(string); !ok {
+				errors = append(errors, fmt.Sprintf("%s must be a string", key))
+			}
+		case "Payload":
+			if _, ok := obj[key].(map[string]interface{}); !ok {
+				errors = append(errors, "Payload must be a dictionary")
+			}
+		default:
+			warnings = append(warnings, fmt.Sprintf("unknown key %s", key))
+		}
+	}
+	for _, key := range []string{"Type", "Identifier", "Payload"} {
+		if _, ok := obj[key]; !ok {
+			errors = append(errors, fmt.Sprintf("missing %s", key))
+		}
+	}
+
+	declarationType, ok := obj["Type"].(string)
+	if !ok {
+		return errors, warnings
+	}
+	dt, ok := mdmDeclarationTypes()[declarationType]
+	if !ok {
+		warning := fmt.Sprintf("unknown declaration type %s", declarationType)
+		if suggestion := closestMDMDeclarationType(declarationType); suggestion != "" {
+			warning += fmt.Sprintf(", did you mean %s?", suggestion)
+		}
+		return errors, append(warnings, warning)
+	}
+
+	payload, ok := obj["Payload"].(map[string]interface{})
+	if !ok || dt.Payload == nil {
+		return errors, warnings
+	}
+	for _, key := range sortedKeys(payload) {
+		pk, ok := dt.Payload[key]
+		if !ok {
+			warnings = append(warnings, fmt.Sprintf("unknown %s payload key %s", declarationType, key))
+		} else if !jsonValueHasType(payload[key], pk.Type) {
+			errors = append(errors, fmt.Sprintf("%s payload key %s must be of type %s", declarationType, key, pk.Type))
+		}
+	}
+	for _, key := range sortedKeys(dt.Payload) {
+		if _, ok := payload[key]; dt.Payload[key].Required && !ok {
+			errors = append(errors, fmt.Sprintf("missing %s payload key %s", declarationType, key))
+		}
+	}
+	return errors, warnings
+}
+
+func jsonValueHasType(v interface{}, t string) bool {
+	switch t {
+	case "string":
+		_, ok := v.(string)
+		return ok
+	case "boolean":
+		_, ok := v.(bool)
+		return ok
+	case "integer":
+		n, ok := v.(json.Number)
+		if !ok {
+			return false
+		}
+		_, err := n.Int64()
+		return err == nil
+	case "number":
+		_, ok := v.(json.Number)
+		return ok
+	case "array":
+		_, ok := v.([]interface{})
+		return ok
+	case "dictionary":
+		_, ok := v.(map[string]interface{})
+		return ok
+	default:
+		return true
+	}
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// closestMDMDeclarationType returns the known declaration type closest to an
+// unknown one, or an empty string if none is close enough.
+func closestMDMDeclarationType(declarationType string) string {
+	return closestString(declarationType, sortedKeys(mdmDeclarationTypes()))
+}
+
+// closestString returns the candidate closest to a string, or an empty string
+// if none is close enough.
+func closestString(s string, candidates []string) string {
+	closest := ""
+	closestDistance := 4
+	for _, c := range candidates {
+		if d := levenshteinDistance(s, c); d < closestDistance {
+			closest = c
+			closestDistance = d
+		}
+	}
+	return closest
+}
+
+func levenshteinDistance(a string, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateMDMDeclarationSource(t *testing.T) {
+	cases := []struct {
+		name     string
+		src      string
+		errors   []string
+		warnings []string
+	}{
+		{
+			"valid",
+			`{"Type":"com.apple.configuration.passcode.settings","Identifier":"yolo","ServerToken":"fomo","Payload":{"MinimumLength":10,"RequirePasscode":true}}`,
+			nil,
+			nil,
+		},
+		{
+			"unchecked payload keys",
+			`{"Type":"com.apple.configuration.safari.settings","Identifier":"yolo","Payload":{"Yolo":"fomo"}}`,
+			nil,
+			nil,
+		},
+		{
+			"invalid JSON",
+			`{"Type":`,
+			[]string{"invalid JSON"},
+			nil,
+		},
+		{
+			"top level keys",
+			`{"Type":"com.apple.configuration.passcode.settings","Identifier":1,"Yolo":"fomo"}`,
+			[]string{"Identifier must be a string", "missing Payload"},
+			[]string{"unknown key Yolo"},
+		},
+		{
+			"unknown type",
+			`{"Type":"com.apple.configuration.passcode.setings","Identifier":"yolo","Payload":{}}`,
+			nil,
+			[]string{"unknown declaration type com.apple.configuration.passcode.setings, did you mean com.apple.configuration.passcode.settings?"},
+		},
+		{
+			"unknown type without suggestion",
+			`{"Type":"com.example.yolo","Identifier":"yolo","Payload":{}}`,
+			nil,
+			[]string{"unknown declaration type com.example.yolo"},
+		},
+		{
+			"payload keys",
+			`{"Type":"com.apple.configuration.passcode.settings","Identifier":"yolo","Payload":{"MinimumLength":10.5,"RequirePasscode":"true","Yolo":1}}`,
+			[]string{
+				"com.apple.configuration.passcode.settings payload key MinimumLength must be of type integer",
+				"com.apple.configuration.passcode.settings payload key RequirePasscode must be of type boolean",
+			},
+			[]string{"unknown com.apple.configuration.passcode.settings payload key Yolo"},
+		},
+		{
+			"required payload key",
+			`{"Type":"com.apple.configuration.legacy","Identifier":"yolo","Payload":{}}`,
+			[]string{"missing com.apple.configuration.legacy payload key ProfileURL"},
+			nil,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			errors, warnings := validateMDMDeclarationSource(c.src)
+			testProblems(t, "error", c.errors, errors)
+			testProblems(t, "warning", c.warnings, warnings)
+		})
+	}
+}
+
+func testProblems(t *testing.T, kind string, expected []string, problems []string) {
+	t.Helper()
+	if len(problems) != len(expected) {
+		t.Fatalf("expected %d %s(s), got %q", len(expected), kind, problems)
+	}
+	for i, problem := range problems {
+		if !strings.HasPrefix(problem, expected[i]) {
+			t.Errorf("expected %s %q, got %q", kind, expected[i], problem)
+		}
+	}
+}
+
+func TestJSONSemanticallyEqual(t *testing.T) {
+	a := `{"Type":"com.apple.configuration.passcode.settings","Payload":{"MinimumLength":10}}`
+	b := "{\n  \"Payload\": {\"MinimumLength\": 10},\n  \"Type\": \"com.apple.configuration.passcode.settings\"\n}\n"
+	c := `{"Type":"com.apple.configuration.passcode.settings","Payload":{"MinimumLength":11}}`
+	if !jsonSemanticallyEqual(a, b) {
+		t.Errorf("expected equal sources")
+	}
+	if jsonSemanticallyEqual(a, c) {
+		t.Errorf("expected different sources")
+	}
+	if jsonSemanticallyEqual(a, "yolo") {
+		t.Errorf("expected invalid source to be different")
+	}
+}
+
+func TestMDMDeclarationSourceAttr(t *testing.T) {
+	src := `{"Identifier":"yolo","ServerToken":1}`
+	if v := mdmDeclarationSourceAttr(src, "Identifier"); v.ValueString() != "yolo" {
+		t.Errorf("unexpected Identifier %s", v)
+	}
+	if v := mdmDeclarationSourceAttr(src, "ServerToken"); !v.IsNull() {
+		t.Errorf("expected null ServerToken, got %s", v)
+	}
+}
@@ -119,8 +119,13 @@ func (r *MDMDEPEnrollmentCustomViewResource) Read(ctx context.Context, req resou
 		return
 	}
 
-	ztlDEPCustomView, _, err := r.client.MDMDEPEnrollmentCustomViews.GetByID(ctx, data.ID.ValueString())
+	ztlDEPCustomView, ztlResp, err := r.client.MDMDEPEnrollmentCustomViews.GetByID(ctx, data.ID.ValueString())
 	if err != nil {
+		if isNotFound(ztlResp, err) {
+			tflog.Warn(ctx, "MDM DEP enrollment custom view not found, removing it from the state", map[string]interface{}{"id": data.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Client error",
 			fmt.Sprintf("Unable to read MDM DEPenrollment custom view %s, got error: %s", data.ID, err),
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -25,6 +26,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &MDMDEPEnrollmentResource{}
 var _ resource.ResourceWithImportState = &MDMDEPEnrollmentResource{}
+var _ resource.ResourceWithConfigValidators = &MDMDEPEnrollmentResource{}
 
 func NewMDMDEPEnrollmentResource() resource.Resource {
 	return &MDMDEPEnrollmentResource{}
@@ -203,6 +205,9 @@ var mdeProfileSchema schema.SingleNestedAttribute = schema.SingleNestedAttribute
 			Optional:            true,
 			Computed:            true,
 			Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			Validators: []validator.Set{
+				setvalidator.ValueStringsAre(mdmDEPSkipKeyValidator{}),
+			},
 		},
 		"language": schema.StringAttribute{
 			Description:         "Two-letter ISO 639-1 code of the enrollment language.",
@@ -210,6 +215,9 @@ var mdeProfileSchema schema.SingleNestedAttribute = schema.SingleNestedAttribute
 			Optional:            true,
 			Computed:            true,
 			Default:             stringdefault.StaticString(""),
+			Validators: []validator.String{
+				mdmDEPLanguageValidator(),
+			},
 		},
 		"region": schema.StringAttribute{
 			Description:         "Two-letter ISO 3166-1 country code.",
@@ -217,6 +225,9 @@ var mdeProfileSchema schema.SingleNestedAttribute = schema.SingleNestedAttribute
 			Optional:            true,
 			Computed:            true,
 			Default:             stringdefault.StaticString(""),
+			Validators: []validator.String{
+				mdmDEPRegionValidator(),
+			},
 		},
 		"department": schema.StringAttribute{
 			Description:         "The user-defined department or location name.",
@@ -260,6 +271,9 @@ var mdeOSVersionEnforcementSchema schema.SingleNestedAttribute = schema.SingleNe
 			Optional:            true,
 			Computed:            true,
 			Default:             stringdefault.StaticString(""),
+			Validators: []validator.String{
+				mdmDEPOSVersionValidator{},
+			},
 		},
 		"auto_ios_min_version_until": schema.StringAttribute{
 			Description:         "If set, the minimum iOS version required for a successful enrollment will be the latest available for the enrolling device until this version (excluded). Set it to 28 for example to automatically require the latest iOS version until (but not including) iOS 28.",
@@ -267,6 +281,9 @@ var mdeOSVersionEnforcementSchema schema.SingleNestedAttribute = schema.SingleNe
 			Optional:            true,
 			Computed:            true,
 			Default:             stringdefault.StaticString(""),
+			Validators: []validator.String{
+				mdmDEPOSVersionValidator{},
+			},
 		},
 		"macos_min_version": schema.StringAttribute{
 			Description:         "The fixed minimum macOS version required for a successful enrollment.",
@@ -274,6 +291,9 @@ var mdeOSVersionEnforcementSchema schema.SingleNestedAttribute = schema.SingleNe
 			Optional:            true,
 			Computed:            true,
 			Default:             stringdefault.StaticString(""),
+			Validators: []validator.String{
+				mdmDEPOSVersionValidator{},
+			},
 		},
 		"auto_macos_min_version_until": schema.StringAttribute{
 			Description:         "If set, the minimum macOS version required for a successful enrollment will be the latest available for the enrolling device until this version (excluded). Set it to 28 for example to automatically require the latest macOS version until (but not including) macOS 28.",
@@ -281,6 +301,9 @@ var mdeOSVersionEnforcementSchema schema.SingleNestedAttribute = schema.SingleNe
 			Optional:            true,
 			Computed:            true,
 			Default:             stringdefault.StaticString(""),
+			Validators: []validator.String{
+				mdmDEPOSVersionValidator{},
+			},
 		},
 	},
 	Optional: true,
@@ -338,6 +361,10 @@ func (r *MDMDEPEnrollmentResource) Schema(ctx context.Context, req resource.Sche
 	}
 }
 
+func (r *MDMDEPEnrollmentResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return mdmDEPEnrollmentConfigValidators()
+}
+
 func (r *MDMDEPEnrollmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -393,8 +420,13 @@ func (r *MDMDEPEnrollmentResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	ztlEnrollment, _, err := r.client.MDMDEPEnrollments.GetByID(ctx, int(data.ID.ValueInt64()))
+	ztlEnrollment, ztlResp, err := r.client.MDMDEPEnrollments.GetByID(ctx, int(data.ID.ValueInt64()))
 	if err != nil {
+		if isNotFound(ztlResp, err) {
+			tflog.Warn(ctx, "MDM DEP enrollment not found, removing it from the state", map[string]interface{}{"id": data.ID.ValueInt64()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Client error",
 			fmt.Sprintf("Unable to read MDM DEP enrollment %d, got error: %s", data.ID.ValueInt64(), err),
@@ -0,0 +1,313 @@
+package provider
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// mdmDEPEnrollmentCatalogueJSON is the catalogue of the Apple SkipKeys, with
+// the OS versions in which they were added, and deprecated, for each
+// platform, and of the ISO 639-1 languages and ISO 3166-1 regions.
+//
+//go:embed mdm_dep_enrollment_catalogue.json
+var mdmDEPEnrollmentCatalogueJSON []byte
+
+type mdmDEPSkipKeyPlatform struct {
+	Added      string `json:"added"`
+	Deprecated string `json:"deprecated"`
+}
+
+type mdmDEPEnrollmentCatalogue struct {
+	SkipKeys  map[string]map[string]mdmDEPSkipKeyPlatform `json:"skip_keys"`
+	Languages []string                                    `json:"languages"`
+	Regions   []string                                    `json:"regions"`
+}
+
+var mdmDEPEnrollmentCatalogueValue = sync.OnceValue(func() mdmDEPEnrollmentCatalogue {
+	var c mdmDEPEnrollmentCatalogue
+	if err := json.Unmarshal(mdmDEPEnrollmentCatalogueJSON, &c); err != nil {
+		panic(fmt.Sprintf("invalid MDM DEP enrollment catalogue: %s", err))
+	}
+	return c
+})
+
+// mdmDEPSkipKeyPlatforms returns the sorted platforms of a skip key, with the
+// OS version in which the key was added, like "iOS 13, macOS 10.14".
+func mdmDEPSkipKeyPlatforms(platforms map[string]mdmDEPSkipKeyPlatform) string {
+	var items []string
+	for _, platform := range sortedKeys(platforms) {
+		items = append(items, fmt.Sprintf("%s %s", platform, platforms[platform].Added))
+	}
+	return strings.Join(items, ", ")
+}
+
+// Skip key validator
+
+var _ validator.String = mdmDEPSkipKeyValidator{}
+
+// mdmDEPSkipKeyValidator warns if a setup pane skip key is not in the
+// catalogue, or if it is deprecated.
+type mdmDEPSkipKeyValidator struct{}
+
+func (v mdmDEPSkipKeyValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v mdmDEPSkipKeyValidator) MarkdownDescription(_ context.Context) string {
+	return "value should be a known Apple SkipKeys setup pane"
+}
+
+func (v mdmDEPSkipKeyValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	key := req.ConfigValue.ValueString()
+	skipKeys := mdmDEPEnrollmentCatalogueValue().SkipKeys
+
+	platforms, ok := skipKeys[key]
+	if !ok {
+		detail := fmt.Sprintf("%q is not a known setup pane skip key.", key)
+		if suggestion := closestString(key, sortedKeys(skipKeys)); suggestion != "" {
+			detail += fmt.Sprintf(" Did you mean %q (%s)?", suggestion, mdmDEPSkipKeyPlatforms(skipKeys[suggestion]))
+		}
+		detail += " The catalogue of the skip keys of this provider version might be outdated."
+		resp.Diagnostics.AddAttributeWarning(req.Path, "Unknown skip key", detail)
+		return
+	}
+
+	var deprecations []string
+	for _, platform := range sortedKeys(platforms) {
+		if deprecated := platforms[platform].Deprecated; deprecated != "" {
+			deprecations = append(deprecations, fmt.Sprintf("%s %s", platform, deprecated))
+		}
+	}
+	if len(deprecations) > 0 {
+		resp.Diagnostics.AddAttributeWarning(
+			req.Path,
+			"Deprecated skip key",
+			fmt.Sprintf("The %q setup pane skip key is deprecated since %s, and is ignored by the devices running these versions.", key, strings.Join(deprecations, " and ")),
+		)
+	}
+}
+
+// ISO code validator
+
+var _ validator.String = mdmDEPISOCodeValidator{}
+
+// mdmDEPISOCodeValidator verifies that a value is empty, or a code of the catalogue.
+type mdmDEPISOCodeValidator struct {
+	standard string
+	kind     string
+	codes    func() []string
+}
+
+func mdmDEPLanguageValidator() mdmDEPISOCodeValidator {
+	return mdmDEPISOCodeValidator{
+		standard: "ISO 639-1",
+		kind:     "language",
+		codes:    func() []string { return mdmDEPEnrollmentCatalogueValue().Languages },
+	}
+}
+
+func mdmDEPRegionValidator() mdmDEPISOCodeValidator {
+	return mdmDEPISOCodeValidator{
+		standard: "ISO 3166-1",
+		kind:     "country",
+		codes:    func() []string { return mdmDEPEnrollmentCatalogueValue().Regions },
+	}
+}
+
+func (v mdmDEPISOCodeValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v mdmDEPISOCodeValidator) MarkdownDescription(_ context.Context) string {
+	return fmt.Sprintf("value must be empty or a two-letter %s %s code", v.standard, v.kind)
+}
+
+func (v mdmDEPISOCodeValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || req.ConfigValue.ValueString() == "" {
+		return
+	}
+	code := req.ConfigValue.ValueString()
+	codes := v.codes()
+	if slices.Contains(codes, code) {
+		return
+	}
+	detail := fmt.Sprintf("%q is not a two-letter %s %s code.", code, v.standard, v.kind)
+	for _, c := range codes {
+		if strings.EqualFold(c, code) {
+			detail += fmt.Sprintf(" Did you mean %q?", c)
+			break
+		}
+	}
+	resp.Diagnostics.AddAttributeError(req.Path, fmt.Sprintf("Invalid %s code", v.kind), detail)
+}
+
+// OS version validator
+
+var _ validator.String = mdmDEPOSVersionValidator{}
+
+// mdmDEPOSVersionValidator verifies that a value is empty, or an OS version like 17, 17.4 or 17.4.1.
+type mdmDEPOSVersionValidator struct{}
+
+func (v mdmDEPOSVersionValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v mdmDEPOSVersionValidator) MarkdownDescription(_ context.Context) string {
+	return "value must be empty or an OS version like 17, 17.4 or 17.4.1"
+}
+
+func (v mdmDEPOSVersionValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || req.ConfigValue.ValueString() == "" {
+		return
+	}
+	if _, err := parseMDMArtifactVersionOSVersion(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid OS version", err.Error())
+	}
+}
+
+// mdmDEPEnrollmentConfigValidators returns the config validators of the MDM DEP enrollment resource.
+func mdmDEPEnrollmentConfigValidators() []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		mdmDEPEnrollmentAccountsValidator{},
+		mdmDEPEnrollmentOSVersionsValidator{},
+	}
+}
+
+// Accounts validator
+
+var _ resource.ConfigValidator = mdmDEPEnrollmentAccountsValidator{}
+
+// mdmDEPEnrollmentAccountsValidator verifies the relationship between the
+// realm, the Setup Assistant user, and the extra admin. The realm user details
+// can only be used with a realm, and the accounts can only be configured while
+// the device waits in the Setup Assistant.
+type mdmDEPEnrollmentAccountsValidator struct{}
+
+func (v mdmDEPEnrollmentAccountsValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v mdmDEPEnrollmentAccountsValidator) MarkdownDescription(_ context.Context) string {
+	return "the realm user details require a realm, the Setup Assistant user can only be admin if it uses the realm user details, and the realm user details and the extra admin require await_device_configured"
+}
+
+func (v mdmDEPEnrollmentAccountsValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var realmUUID types.String
+	var useRealmUser, realmUserIsAdmin, awaitDeviceConfigured types.Bool
+	var extraAdmin types.Object
+	authentication := path.Root("authentication")
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, authentication.AtName("realm_uuid"), &realmUUID)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, authentication.AtName("use_for_setup_assistant_user"), &useRealmUser)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, authentication.AtName("setup_assistant_user_is_admin"), &realmUserIsAdmin)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("profile").AtName("await_device_configured"), &awaitDeviceConfigured)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("extra_admin"), &extraAdmin)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// same default as in the resource schema
+	awaitKnown := !awaitDeviceConfigured.IsUnknown()
+	await := awaitDeviceConfigured.ValueBool()
+
+	if useRealmUser.ValueBool() {
+		if !realmUUID.IsUnknown() && realmUUID.ValueString() == "" {
+			resp.Diagnostics.AddAttributeError(
+				authentication.AtName("realm_uuid"),
+				"Invalid Setup Assistant user",
+				"realm_uuid is required to use the realm user details for the Setup Assistant user.",
+			)
+		}
+		if awaitKnown && !await {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("profile").AtName("await_device_configured"),
+				"Invalid Setup Assistant configuration",
+				"await_device_configured must be true to use the realm user details for the Setup Assistant user.",
+			)
+		}
+	} else if realmUserIsAdmin.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			authentication.AtName("setup_assistant_user_is_admin"),
+			"Invalid Setup Assistant user",
+			"The Setup Assistant user can only be admin if use_for_setup_assistant_user is true.",
+		)
+	}
+
+	if !extraAdmin.IsNull() && !extraAdmin.IsUnknown() {
+		if awaitKnown && !await {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("profile").AtName("await_device_configured"),
+				"Invalid Setup Assistant configuration",
+				"await_device_configured must be true to create the extra admin.",
+			)
+		}
+	} else if extraAdmin.IsNull() && useRealmUser.ValueBool() && !realmUserIsAdmin.IsUnknown() && !realmUserIsAdmin.ValueBool() {
+		resp.Diagnostics.AddAttributeWarning(
+			authentication.AtName("setup_assistant_user_is_admin"),
+			"No admin account",
+			"The Setup Assistant user is not admin, and no extra admin is configured. The enrolled macOS devices will not have any local admin account.",
+		)
+	}
+}
+
+// OS versions validator
+
+var _ resource.ConfigValidator = mdmDEPEnrollmentOSVersionsValidator{}
+
+// mdmDEPEnrollmentOSVersionsValidator verifies that the automatic minimum OS
+// versions are set until a version above the fixed minimum OS versions.
+type mdmDEPEnrollmentOSVersionsValidator struct{}
+
+func (v mdmDEPEnrollmentOSVersionsValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v mdmDEPEnrollmentOSVersionsValidator) MarkdownDescription(_ context.Context) string {
+	return "the automatic minimum OS versions must be set until a version above the fixed minimum OS versions"
+}
+
+func (v mdmDEPEnrollmentOSVersionsValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	osVersionEnforcement := path.Root("os_version_enforcement")
+	for _, platform := range []struct {
+		attr string
+		name string
+	}{
+		{"ios", "iOS"},
+		{"macos", "macOS"},
+	} {
+		var minVersion, autoMinVersionUntil types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, osVersionEnforcement.AtName(platform.attr+"_min_version"), &minVersion)...)
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, osVersionEnforcement.AtName("auto_"+platform.attr+"_min_version_until"), &autoMinVersionUntil)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		// the syntax errors are reported by the attribute validators
+		minComponents, err := parseMDMArtifactVersionOSVersion(minVersion.ValueString())
+		if err != nil {
+			continue
+		}
+		untilComponents, err := parseMDMArtifactVersionOSVersion(autoMinVersionUntil.ValueString())
+		if err != nil {
+			continue
+		}
+		if compareMDMArtifactVersionOSVersions(minComponents, untilComponents) >= 0 {
+			resp.Diagnostics.AddAttributeError(
+				osVersionEnforcement.AtName("auto_"+platform.attr+"_min_version_until"),
+				"Invalid OS version enforcement",
+				fmt.Sprintf("The automatic %s minimum version must be set until a version above the fixed %s minimum version %s.", platform.name, platform.name, minVersion.ValueString()),
+			)
+		}
+	}
+}
@@ -0,0 +1,328 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func testDiagnosticDetails(diags diag.Diagnostics) []string {
+	var details []string
+	for _, d := range diags {
+		details = append(details, d.Detail())
+	}
+	return details
+}
+
+func TestMDMDEPEnrollmentStringValidators(t *testing.T) {
+	cases := []struct {
+		name      string
+		validator validator.String
+		value     types.String
+		errors    []string
+		warnings  []string
+	}{
+		{"skip key", mdmDEPSkipKeyValidator{}, types.StringValue("ActionButton"), nil, nil},
+		{"unknown skip key value", mdmDEPSkipKeyValidator{}, types.StringUnknown(), nil, nil},
+		{
+			"unknown skip key",
+			mdmDEPSkipKeyValidator{},
+			types.StringValue("Yolo"),
+			nil,
+			[]string{`"Yolo" is not a known setup pane skip key.`},
+		},
+		{
+			"skip key suggestion",
+			mdmDEPSkipKeyValidator{},
+			types.StringValue("Apparence"),
+			nil,
+			[]string{`"Apparence" is not a known setup pane skip key. Did you mean "Appearance" (iOS 13, macOS 10.14)? The catalogue`},
+		},
+		{
+			"deprecated skip key",
+			mdmDEPSkipKeyValidator{},
+			types.StringValue("DisplayTone"),
+			nil,
+			[]string{`The "DisplayTone" setup pane skip key is deprecated since iOS 15 and macOS 13`},
+		},
+		{"empty language", mdmDEPLanguageValidator(), types.StringValue(""), nil, nil},
+		{"language", mdmDEPLanguageValidator(), types.StringValue("fr"), nil, nil},
+		{
+			"invalid language",
+			mdmDEPLanguageValidator(),
+			types.StringValue("FR"),
+			[]string{`"FR" is not a two-letter ISO 639-1 language code. Did you mean "fr"?`},
+			nil,
+		},
+		{"region", mdmDEPRegionValidator(), types.StringValue("DE"), nil, nil},
+		{
+			"invalid region",
+			mdmDEPRegionValidator(),
+			types.StringValue("XX"),
+			[]string{`"XX" is not a two-letter ISO 3166-1 country code.`},
+			nil,
+		},
+		{"empty OS version", mdmDEPOSVersionValidator{}, types.StringValue(""), nil, nil},
+		{"OS version", mdmDEPOSVersionValidator{}, types.StringValue("26.2.1"), nil, nil},
+		{
+			"invalid OS version",
+			mdmDEPOSVersionValidator{},
+			types.StringValue("26.x"),
+			[]string{`"26.x" is not a valid OS version`},
+			nil,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("test"), ConfigValue: c.value}
+			resp := &validator.StringResponse{}
+			c.validator.ValidateString(context.Background(), req, resp)
+			for diagType, expected := range map[string][]string{
+				"error":   c.errors,
+				"warning": c.warnings,
+			} {
+				var got []string
+				if diagType == "error" {
+					got = testDiagnosticDetails(resp.Diagnostics.Errors())
+				} else {
+					got = testDiagnosticDetails(resp.Diagnostics.Warnings())
+				}
+				if len(got) != len(expected) {
+					t.Fatalf("expected %d %s(s), got %q", len(expected), diagType, got)
+				}
+				for i, detail := range got {
+					if !strings.HasPrefix(detail, expected[i]) {
+						t.Errorf("expected %s %q, got %q", diagType, expected[i], detail)
+					}
+				}
+			}
+		})
+	}
+}
+
+func testMDMDEPEnrollmentConfig(t *testing.T, values map[string]tftypes.Value) tfsdk.Config {
+	ctx := context.Background()
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"authentication": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"realm_uuid":                    schema.StringAttribute{Required: true},
+					"use_for_setup_assistant_user":  schema.BoolAttribute{Required: true},
+					"setup_assistant_user_is_admin": schema.BoolAttribute{Required: true},
+				},
+				Optional: true,
+			},
+			"extra_admin": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"short_name": schema.StringAttribute{Required: true},
+				},
+				Optional: true,
+			},
+			"profile": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"await_device_configured": schema.BoolAttribute{Optional: true},
+				},
+				Required: true,
+			},
+			"os_version_enforcement": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"ios_min_version":              schema.StringAttribute{Optional: true},
+					"auto_ios_min_version_until":   schema.StringAttribute{Optional: true},
+					"macos_min_version":            schema.StringAttribute{Optional: true},
+					"auto_macos_min_version_until": schema.StringAttribute{Optional: true},
+				},
+				Optional: true,
+			},
+		},
+	}
+	objType := s.Type().TerraformType(ctx).(tftypes.Object)
+	rawValues := make(map[string]tftypes.Value)
+	for name, attrType := range objType.AttributeTypes {
+		objAttrType := attrType.(tftypes.Object)
+		if v, ok := values[name]; ok {
+			rawAttrValues := make(map[string]tftypes.Value)
+			for attrName, subAttrType := range objAttrType.AttributeTypes {
+				rawAttrValues[attrName] = tftypes.NewValue(subAttrType, nil)
+			}
+			var attrValues map[string]tftypes.Value
+			if err := v.As(&attrValues); err != nil {
+				t.Fatalf("invalid %s value: %s", name, err)
+			}
+			for attrName, attrValue := range attrValues {
+				rawAttrValues[attrName] = attrValue
+			}
+			rawValues[name] = tftypes.NewValue(objAttrType, rawAttrValues)
+		} else {
+			rawValues[name] = tftypes.NewValue(objAttrType, nil)
+		}
+	}
+	return tfsdk.Config{Schema: s, Raw: tftypes.NewValue(objType, rawValues)}
+}
+
+func testMDMDEPEnrollmentObject(values map[string]tftypes.Value) tftypes.Value {
+	attrTypes := make(map[string]tftypes.Type)
+	for name, v := range values {
+		attrTypes[name] = v.Type()
+	}
+	return tftypes.NewValue(tftypes.Object{AttributeTypes: attrTypes}, values)
+}
+
+func TestMDMDEPEnrollmentConfigValidators(t *testing.T) {
+	realmUser := func(isAdmin bool) tftypes.Value {
+		return testMDMDEPEnrollmentObject(map[string]tftypes.Value{
+			"realm_uuid":                    tftypes.NewValue(tftypes.String, "0b8a5a0c-7b35-4e1a-9a8f-5e4c7cbb1a2e"),
+			"use_for_setup_assistant_user":  tftypes.NewValue(tftypes.Bool, true),
+			"setup_assistant_user_is_admin": tftypes.NewValue(tftypes.Bool, isAdmin),
+		})
+	}
+	awaitDeviceConfigured := func(await interface{}) tftypes.Value {
+		return testMDMDEPEnrollmentObject(map[string]tftypes.Value{
+			"await_device_configured": tftypes.NewValue(tftypes.Bool, await),
+		})
+	}
+	extraAdmin := testMDMDEPEnrollmentObject(map[string]tftypes.Value{
+		"short_name": tftypes.NewValue(tftypes.String, "ouradmin"),
+	})
+
+	cases := []struct {
+		name     string
+		values   map[string]tftypes.Value
+		errors   []string
+		warnings []string
+	}{
+		{
+			"defaults",
+			map[string]tftypes.Value{"profile": awaitDeviceConfigured(nil)},
+			nil,
+			nil,
+		},
+		{
+			"valid",
+			map[string]tftypes.Value{
+				"authentication": realmUser(true),
+				"extra_admin":    extraAdmin,
+				"profile":        awaitDeviceConfigured(true),
+				"os_version_enforcement": testMDMDEPEnrollmentObject(map[string]tftypes.Value{
+					"ios_min_version":              tftypes.NewValue(tftypes.String, "26.2"),
+					"auto_ios_min_version_until":   tftypes.NewValue(tftypes.String, "27"),
+					"macos_min_version":            tftypes.NewValue(tftypes.String, ""),
+					"auto_macos_min_version_until": tftypes.NewValue(tftypes.String, "28"),
+				}),
+			},
+			nil,
+			nil,
+		},
+		{
+			"unknown await device configured",
+			map[string]tftypes.Value{
+				"authentication": realmUser(true),
+				"profile":        awaitDeviceConfigured(tftypes.UnknownValue),
+			},
+			nil,
+			nil,
+		},
+		{
+			"realm user without await device configured",
+			map[string]tftypes.Value{
+				"authentication": realmUser(true),
+				"profile":        awaitDeviceConfigured(nil),
+			},
+			[]string{"await_device_configured must be true to use the realm user details for the Setup Assistant user."},
+			nil,
+		},
+		{
+			"realm user without realm",
+			map[string]tftypes.Value{
+				"authentication": testMDMDEPEnrollmentObject(map[string]tftypes.Value{
+					"realm_uuid":                    tftypes.NewValue(tftypes.String, ""),
+					"use_for_setup_assistant_user":  tftypes.NewValue(tftypes.Bool, true),
+					"setup_assistant_user_is_admin": tftypes.NewValue(tftypes.Bool, true),
+				}),
+				"profile": awaitDeviceConfigured(true),
+			},
+			[]string{"realm_uuid is required to use the realm user details for the Setup Assistant user."},
+			nil,
+		},
+		{
+			"admin without realm user",
+			map[string]tftypes.Value{
+				"authentication": testMDMDEPEnrollmentObject(map[string]tftypes.Value{
+					"realm_uuid":                    tftypes.NewValue(tftypes.String, "0b8a5a0c-7b35-4e1a-9a8f-5e4c7cbb1a2e"),
+					"use_for_setup_assistant_user":  tftypes.NewValue(tftypes.Bool, false),
+					"setup_assistant_user_is_admin": tftypes.NewValue(tftypes.Bool, true),
+				}),
+				"profile": awaitDeviceConfigured(false),
+			},
+			[]string{"The Setup Assistant user can only be admin if use_for_setup_assistant_user is true."},
+			nil,
+		},
+		{
+			"extra admin without await device configured",
+			map[string]tftypes.Value{
+				"extra_admin": extraAdmin,
+				"profile":     awaitDeviceConfigured(false),
+			},
+			[]string{"await_device_configured must be true to create the extra admin."},
+			nil,
+		},
+		{
+			"no admin account",
+			map[string]tftypes.Value{
+				"authentication": realmUser(false),
+				"profile":        awaitDeviceConfigured(true),
+			},
+			nil,
+			[]string{"The Setup Assistant user is not admin, and no extra admin is configured."},
+		},
+		{
+			"auto min version until below fixed min version",
+			map[string]tftypes.Value{
+				"profile": awaitDeviceConfigured(nil),
+				"os_version_enforcement": testMDMDEPEnrollmentObject(map[string]tftypes.Value{
+					"macos_min_version":            tftypes.NewValue(tftypes.String, "26.3"),
+					"auto_macos_min_version_until": tftypes.NewValue(tftypes.String, "26"),
+				}),
+			},
+			[]string{"The automatic macOS minimum version must be set until a version above the fixed macOS minimum version 26.3."},
+			nil,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.Background()
+			req := resource.ValidateConfigRequest{Config: testMDMDEPEnrollmentConfig(t, c.values)}
+			resp := &resource.ValidateConfigResponse{}
+			for _, v := range mdmDEPEnrollmentConfigValidators() {
+				v.ValidateResource(ctx, req, resp)
+			}
+			for diagType, expected := range map[string][]string{
+				"error":   c.errors,
+				"warning": c.warnings,
+			} {
+				var got []string
+				if diagType == "error" {
+					got = testDiagnosticDetails(resp.Diagnostics.Errors())
+				} else {
+					got = testDiagnosticDetails(resp.Diagnostics.Warnings())
+				}
+				if len(got) != len(expected) {
+					t.Fatalf("expected %d %s(s), got %q", len(expected), diagType, got)
+				}
+				for i, detail := range got {
+					if !strings.HasPrefix(detail, expected[i]) {
+						t.Errorf("expected %s %q, got %q", diagType, expected[i], detail)
+					}
+				}
+			}
+		})
+	}
+}
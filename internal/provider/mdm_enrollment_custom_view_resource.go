@@ -127,8 +127,13 @@ func (r *MDMEnrollmentCustomViewResource) Read(ctx context.Context, req resource
 		return
 	}
 
-	ztlCustomView, _, err := r.client.MDMEnrollmentCustomViews.GetByID(ctx, data.ID.ValueString())
+	ztlCustomView, ztlResp, err := r.client.MDMEnrollmentCustomViews.GetByID(ctx, data.ID.ValueString())
 	if err != nil {
+		if isNotFound(ztlResp, err) {
+			tflog.Warn(ctx, "MDM enrollment custom view not found, removing it from the state", map[string]interface{}{"id": data.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Client error",
 			fmt.Sprintf("Unable to read MDM enrollment custom view %s, got error: %s", data.ID, err),
@@ -21,6 +21,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &MDMEnterpriseAppResource{}
 var _ resource.ResourceWithImportState = &MDMEnterpriseAppResource{}
+var _ resource.ResourceWithConfigValidators = &MDMEnterpriseAppResource{}
 
 func NewMDMEnterpriseAppResource() resource.Resource {
 	return &MDMEnterpriseAppResource{}
@@ -226,6 +227,10 @@ func (r *MDMEnterpriseAppResource) Schema(ctx context.Context, req resource.Sche
 	}
 }
 
+func (r *MDMEnterpriseAppResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return mdmArtifactVersionConfigValidators()
+}
+
 func (r *MDMEnterpriseAppResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -281,8 +286,13 @@ func (r *MDMEnterpriseAppResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	ztlMEA, _, err := r.client.MDMEnterpriseApps.GetByID(ctx, data.ID.ValueString())
+	ztlMEA, ztlResp, err := r.client.MDMEnterpriseApps.GetByID(ctx, data.ID.ValueString())
 	if err != nil {
+		if isNotFound(ztlResp, err) {
+			tflog.Warn(ctx, "MDM enterprise app not found, removing it from the state", map[string]interface{}{"id": data.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Client error",
 			fmt.Sprintf("Unable to read MDM enterprise app %s, got error: %s", data.ID.ValueString(), err),
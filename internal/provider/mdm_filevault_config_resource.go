@@ -157,8 +157,13 @@ func (r *MDMFileVaultConfigResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
-	ztlMFC, _, err := r.client.MDMFileVaultConfigs.GetByID(ctx, int(data.ID.ValueInt64()))
+	ztlMFC, ztlResp, err := r.client.MDMFileVaultConfigs.GetByID(ctx, int(data.ID.ValueInt64()))
 	if err != nil {
+		if isNotFound(ztlResp, err) {
+			tflog.Warn(ctx, "MDM FileVault configuration not found, removing it from the state", map[string]interface{}{"id": data.ID.ValueInt64()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Client error",
 			fmt.Sprintf("Unable to read MDM FileVault configuration %d, got error: %s", data.ID.ValueInt64(), err),
@@ -1,6 +1,11 @@
 package provider
 
 import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/zentralopensource/goztl"
 )
@@ -22,3 +27,58 @@ func mdmLocationAssetForState(mla *goztl.MDMLocationAsset) mdmLocationAsset {
 		PricingParam: types.StringValue(mla.PricingParam),
 	}
 }
+
+// mdmLocationAssetsPath is the Zentral API endpoint of the MDM location assets.
+const mdmLocationAssetsPath = "mdm/location_assets/"
+
+// The MDM location assets are listed directly, because the license counts
+// are not returned by the goztl client. The fields are the ones of the
+// Zentral LocationAsset model, with the Adam ID and pricing param of the
+// asset. The name, bundle ID and supported platforms of the asset are not
+// returned by the Zentral API.
+
+type mdmLocationAssetObject struct {
+	ID             int    `json:"id"`
+	LocationID     int    `json:"location"`
+	AssetID        int    `json:"asset"`
+	AdamID         string `json:"adam_id"`
+	PricingParam   string `json:"pricing_param"`
+	TotalCount     int    `json:"total_count"`
+	AssignedCount  int    `json:"assigned_count"`
+	AvailableCount int    `json:"available_count"`
+}
+
+func listMDMLocationAssets(ctx context.Context, c *goztl.Client, filters mdmLocationAssetFilters) ([]mdmLocationAssetObject, *goztl.Response, error) {
+	query := url.Values{}
+	query.Set("location_id", strconv.Itoa(filters.LocationID))
+	if filters.AdamID != "" {
+		query.Set("adam_id", filters.AdamID)
+	}
+	if filters.PricingParam != "" {
+		query.Set("pricing_param", filters.PricingParam)
+	}
+	req, err := c.NewRequest(ctx, http.MethodGet, mdmLocationAssetsPath+"?"+query.Encode(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var mlas []mdmLocationAssetObject
+	resp, err := c.Do(ctx, req, &mlas)
+	if err != nil {
+		return nil, resp, err
+	}
+	return mlas, resp, nil
+}
+
+// mdmLocationAssetFilters are the optional filters of the location assets
+// data source. The empty filters match all the assets.
+type mdmLocationAssetFilters struct {
+	LocationID   int
+	AdamID       string
+	PricingParam string
+}
+
+func (f mdmLocationAssetFilters) match(mla mdmLocationAssetObject) bool {
+	return mla.LocationID == f.LocationID &&
+		(f.AdamID == "" || mla.AdamID == f.AdamID) &&
+		(f.PricingParam == "" || mla.PricingParam == f.PricingParam)
+}
@@ -0,0 +1,27 @@
+package provider
+
+import "testing"
+
+func TestMDMLocationAssetFiltersMatch(t *testing.T) {
+	mla := mdmLocationAssetObject{
+		LocationID:   3,
+		AdamID:       "803453959",
+		PricingParam: "STDQ",
+	}
+	cases := []struct {
+		filters  mdmLocationAssetFilters
+		expected bool
+	}{
+		{mdmLocationAssetFilters{LocationID: 3}, true},
+		{mdmLocationAssetFilters{LocationID: 4}, false},
+		{mdmLocationAssetFilters{LocationID: 3, AdamID: "803453959", PricingParam: "STDQ"}, true},
+		{mdmLocationAssetFilters{LocationID: 3, AdamID: "803453959", PricingParam: "PLUS"}, false},
+		{mdmLocationAssetFilters{LocationID: 3, PricingParam: "STDQ"}, true},
+		{mdmLocationAssetFilters{LocationID: 3, AdamID: "361309726"}, false},
+	}
+	for _, c := range cases {
+		if got := c.filters.match(mla); got != c.expected {
+			t.Errorf("%+v: expected %t, got %t", c.filters, c.expected, got)
+		}
+	}
+}
@@ -0,0 +1,205 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zentralopensource/goztl"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &MDMLocationAssetsDataSource{}
+
+func NewMDMLocationAssetsDataSource() datasource.DataSource {
+	return &MDMLocationAssetsDataSource{}
+}
+
+// MDMLocationAssetsDataSource defines the data source implementation.
+type MDMLocationAssetsDataSource struct {
+	client *goztl.Client
+}
+
+type mdmLocationAssets struct {
+	LocationID     types.Int64  `tfsdk:"location_id"`
+	AdamID         types.String `tfsdk:"adam_id"`
+	PricingParam   types.String `tfsdk:"pricing_param"`
+	LocationAssets types.List   `tfsdk:"location_assets"`
+}
+
+var mdmLocationAssetsItemAttrTypes = map[string]attr.Type{
+	"id":              types.Int64Type,
+	"location_id":     types.Int64Type,
+	"asset_id":        types.Int64Type,
+	"adam_id":         types.StringType,
+	"pricing_param":   types.StringType,
+	"total_count":     types.Int64Type,
+	"assigned_count":  types.Int64Type,
+	"available_count": types.Int64Type,
+}
+
+func mdmLocationAssetsItemForState(mla mdmLocationAssetObject) types.Object {
+	return types.ObjectValueMust(
+		mdmLocationAssetsItemAttrTypes,
+		map[string]attr.Value{
+			"id":              types.Int64Value(int64(mla.ID)),
+			"location_id":     types.Int64Value(int64(mla.LocationID)),
+			"asset_id":        types.Int64Value(int64(mla.AssetID)),
+			"adam_id":         types.StringValue(mla.AdamID),
+			"pricing_param":   types.StringValue(mla.PricingParam),
+			"total_count":     types.Int64Value(int64(mla.TotalCount)),
+			"assigned_count":  types.Int64Value(int64(mla.AssignedCount)),
+			"available_count": types.Int64Value(int64(mla.AvailableCount)),
+		},
+	)
+}
+
+func (d *MDMLocationAssetsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mdm_location_assets"
+}
+
+func (d *MDMLocationAssetsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Allows details of the assets of a MDM location to be retrieved, optionally filtered by Adam ID or pricing param. The assets cannot be filtered by bundle ID or platform, because they are not returned by the Zentral API.",
+		MarkdownDescription: "The data source `zentral_mdm_location_assets` allows details of the assets of a MDM location to be retrieved, optionally filtered by `Adam ID` or pricing param. The assets cannot be filtered by bundle ID or platform, because they are not returned by the Zentral API.",
+
+		Attributes: map[string]schema.Attribute{
+			"location_id": schema.Int64Attribute{
+				Description:         "ID of the location.",
+				MarkdownDescription: "`ID` of the location.",
+				Required:            true,
+			},
+			"adam_id": schema.StringAttribute{
+				Description:         "Only return the assets with this Adam ID.",
+				MarkdownDescription: "Only return the assets with this `Adam ID`.",
+				Optional:            true,
+			},
+			"pricing_param": schema.StringAttribute{
+				Description:         "Only return the assets with this pricing param. STDQ or PLUS.",
+				MarkdownDescription: "Only return the assets with this pricing param. `STDQ` or `PLUS`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"STDQ", "PLUS"}...),
+				},
+			},
+			"location_assets": schema.ListNestedAttribute{
+				Description:         "List of the location assets, sorted by ID.",
+				MarkdownDescription: "List of the location assets, sorted by ID.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description:         "ID of the MDM location asset.",
+							MarkdownDescription: "`ID` of the MDM location asset.",
+							Computed:            true,
+						},
+						"location_id": schema.Int64Attribute{
+							Description:         "ID of the location.",
+							MarkdownDescription: "`ID` of the location.",
+							Computed:            true,
+						},
+						"asset_id": schema.Int64Attribute{
+							Description:         "ID of the asset.",
+							MarkdownDescription: "`ID` of the asset.",
+							Computed:            true,
+						},
+						"adam_id": schema.StringAttribute{
+							Description:         "Adam ID of the asset.",
+							MarkdownDescription: "`Adam ID` of the asset.",
+							Computed:            true,
+						},
+						"pricing_param": schema.StringAttribute{
+							Description:         "Pricing param of the asset.",
+							MarkdownDescription: "Pricing param of the asset.",
+							Computed:            true,
+						},
+						"total_count": schema.Int64Attribute{
+							Description:         "Number of licenses.",
+							MarkdownDescription: "Number of licenses.",
+							Computed:            true,
+						},
+						"assigned_count": schema.Int64Attribute{
+							Description:         "Number of assigned licenses.",
+							MarkdownDescription: "Number of assigned licenses.",
+							Computed:            true,
+						},
+						"available_count": schema.Int64Attribute{
+							Description:         "Number of available licenses.",
+							MarkdownDescription: "Number of available licenses.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *MDMLocationAssetsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*goztl.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *goztl.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *MDMLocationAssetsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data mdmLocationAssets
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filters := mdmLocationAssetFilters{
+		LocationID:   int(data.LocationID.ValueInt64()),
+		AdamID:       data.AdamID.ValueString(),
+		PricingParam: data.PricingParam.ValueString(),
+	}
+
+	ztlMLAs, _, err := listMDMLocationAssets(ctx, d.client, filters)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to list MDM location assets, got error: %s", err),
+		)
+		return
+	}
+	sort.Slice(ztlMLAs, func(i, j int) bool { return ztlMLAs[i].ID < ztlMLAs[j].ID })
+
+	mlasForState := make([]types.Object, 0)
+	for _, mla := range ztlMLAs {
+		if filters.match(mla) {
+			mlasForState = append(mlasForState, mdmLocationAssetsItemForState(mla))
+		}
+	}
+
+	locationAssets, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: mdmLocationAssetsItemAttrTypes}, mlasForState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.LocationAssets = locationAssets
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccMDMLocationAssetsDataSource(t *testing.T) {
+	ds1ResourceName := "data.zentral_mdm_location_assets.check1"
+	ds2ResourceName := "data.zentral_mdm_location_assets.check2"
+	ds3ResourceName := "data.zentral_mdm_location_assets.check3"
+	countRegexp := regexp.MustCompile(`^\d+$`)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMDMLocationAssetsDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Filtered by Adam ID and pricing param
+					resource.TestCheckResourceAttr(
+						ds1ResourceName, "location_assets.#", "1"),
+					resource.TestCheckResourceAttr(
+						ds1ResourceName, "location_assets.0.id", "19"),
+					resource.TestCheckResourceAttr(
+						ds1ResourceName, "location_assets.0.location_id", "3"),
+					resource.TestCheckResourceAttr(
+						ds1ResourceName, "location_assets.0.asset_id", "1"),
+					resource.TestCheckResourceAttr(
+						ds1ResourceName, "location_assets.0.adam_id", "803453959"),
+					resource.TestCheckResourceAttr(
+						ds1ResourceName, "location_assets.0.pricing_param", "STDQ"),
+					resource.TestMatchResourceAttr(
+						ds1ResourceName, "location_assets.0.total_count", countRegexp),
+					resource.TestMatchResourceAttr(
+						ds1ResourceName, "location_assets.0.assigned_count", countRegexp),
+					resource.TestMatchResourceAttr(
+						ds1ResourceName, "location_assets.0.available_count", countRegexp),
+					// Filtered by pricing param
+					resource.TestCheckTypeSetElemNestedAttrs(
+						ds2ResourceName, "location_assets.*",
+						map[string]string{
+							"id":            "19",
+							"location_id":   "3",
+							"asset_id":      "1",
+							"adam_id":       "803453959",
+							"pricing_param": "STDQ",
+						},
+					),
+					// Filtered by unknown Adam ID
+					resource.TestCheckResourceAttr(
+						ds3ResourceName, "location_assets.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+// TODO: hard coded values of a provisioned location asset
+// on the server used for the integration tests
+func testAccMDMLocationAssetsDataSourceConfig() string {
+	return `
+data "zentral_mdm_location" "check1" {
+  name = "Terraform Provider CI/CD"
+}
+
+data "zentral_mdm_location_assets" "check1" {
+  location_id   = data.zentral_mdm_location.check1.id
+  adam_id       = "803453959"
+  pricing_param = "STDQ"
+}
+
+data "zentral_mdm_location_assets" "check2" {
+  location_id   = data.zentral_mdm_location.check1.id
+  pricing_param = "STDQ"
+}
+
+data "zentral_mdm_location_assets" "check3" {
+  location_id = data.zentral_mdm_location.check1.id
+  adam_id     = "1"
+}
+`
+}
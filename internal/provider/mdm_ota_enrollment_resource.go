@@ -180,8 +180,13 @@ func (r *MDMOTAEnrollmentResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	ztlMOE, _, err := r.client.MDMOTAEnrollments.GetByID(ctx, int(data.ID.ValueInt64()))
+	ztlMOE, ztlResp, err := r.client.MDMOTAEnrollments.GetByID(ctx, int(data.ID.ValueInt64()))
 	if err != nil {
+		if isNotFound(ztlResp, err) {
+			tflog.Warn(ctx, "MDM OTA enrollment not found, removing it from the state", map[string]interface{}{"id": data.ID.ValueInt64()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Client error",
 			fmt.Sprintf("Unable to read MDM OTA enrollment %d, got error: %s", data.ID.ValueInt64(), err),
@@ -175,8 +175,13 @@ func (r *MDMPackageResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	ztlMP, _, err := r.client.MDMPackages.GetByID(ctx, data.ID.ValueString())
+	ztlMP, ztlResp, err := r.client.MDMPackages.GetByID(ctx, data.ID.ValueString())
 	if err != nil {
+		if isNotFound(ztlResp, err) {
+			tflog.Warn(ctx, "MDM package not found, removing it from the state", map[string]interface{}{"id": data.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Client error",
 			fmt.Sprintf("Unable to read MDM package %s, got error: %s", data.ID.ValueString(), err),
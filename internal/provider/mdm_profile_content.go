@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"howett.net/plist"
+)
+
+const (
+	mdmProfileDiffMaxLines      = 50
+	mdmProfileDiffMaxValueChars = 60
+)
+
+// mdmProfilePayload is a parsed configuration profile.
+type mdmProfilePayload struct {
+	raw  []byte
+	dict map[string]interface{}
+}
+
+// readMDMProfileContent returns the configuration profile content. The
+// content attribute value is either the profile itself, as a XML or binary
+// property list, or the path to the profile file.
+func readMDMProfileContent(content string) ([]byte, error) {
+	trimmed := strings.TrimSpace(content)
+	if strings.HasPrefix(trimmed, "<") || strings.HasPrefix(trimmed, "bplist") {
+		return []byte(content), nil
+	}
+	b, err := os.ReadFile(content)
+	if err != nil {
+		return nil, fmt.Errorf("not a property list, and not a readable file: %w", err)
+	}
+	return b, nil
+}
+
+// parseMDMProfile parses and verifies a configuration profile.
+func parseMDMProfile(b []byte) (*mdmProfilePayload, error) {
+	var dict map[string]interface{}
+	if _, err := plist.Unmarshal(b, &dict); err != nil {
+		return nil, fmt.Errorf("invalid property list: %w", err)
+	}
+	if payloadType, _ := dict["PayloadType"].(string); payloadType != "Configuration" {
+		return nil, errors.New("PayloadType must be Configuration")
+	}
+	for _, key := range []string{"PayloadIdentifier", "PayloadUUID"} {
+		if v, _ := dict[key].(string); v == "" {
+			return nil, fmt.Errorf("missing %s", key)
+		}
+	}
+	return &mdmProfilePayload{raw: b, dict: dict}, nil
+}
+
+// mdmProfilePayloadWithContent returns the configuration profile of a content attribute value.
+func mdmProfilePayloadWithContent(content string) (*mdmProfilePayload, error) {
+	b, err := readMDMProfileContent(content)
+	if err != nil {
+		return nil, err
+	}
+	return parseMDMProfile(b)
+}
+
+// mdmProfilePayloadWithSource returns the configuration profile of a source
+// attribute value, or nil if the source is not a plain configuration profile,
+// like a signed one.
+func mdmProfilePayloadWithSource(source string) *mdmProfilePayload {
+	b, err := base64.StdEncoding.DecodeString(source)
+	if err != nil {
+		return nil
+	}
+	p, err := parseMDMProfile(b)
+	if err != nil {
+		return nil
+	}
+	return p
+}
+
+func (p *mdmProfilePayload) source() string {
+	return base64.StdEncoding.EncodeToString(p.raw)
+}
+
+func (p *mdmProfilePayload) stringAttr(key string) types.String {
+	if p == nil {
+		return types.StringNull()
+	}
+	if v, ok := p.dict[key].(string); ok {
+		return types.StringValue(v)
+	}
+	return types.StringNull()
+}
+
+// equal returns true if both configuration profiles have the same keys and values.
+func (p *mdmProfilePayload) equal(o *mdmProfilePayload) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return reflect.DeepEqual(p.dict, o.dict)
+}
+
+// plistDiff returns the differences between two property list values, as
+// one line per added (+), removed (-) or changed (~) key path.
+func plistDiff(keyPath string, old interface{}, new interface{}) []string {
+	oldDict, oldIsDict := old.(map[string]interface{})
+	newDict, newIsDict := new.(map[string]interface{})
+	if oldIsDict && newIsDict {
+		keys := make(map[string]bool)
+		for k := range oldDict {
+			keys[k] = true
+		}
+		for k := range newDict {
+			keys[k] = true
+		}
+		sortedKeys := make([]string, 0, len(keys))
+		for k := range keys {
+			sortedKeys = append(sortedKeys, k)
+		}
+		sort.Strings(sortedKeys)
+		var lines []string
+		for _, k := range sortedKeys {
+			lines = append(lines, plistDiffItem(plistDiffPath(keyPath, k), oldDict, newDict, k)...)
+		}
+		return lines
+	}
+	oldArray, oldIsArray := old.([]interface{})
+	newArray, newIsArray := new.([]interface{})
+	if oldIsArray && newIsArray {
+		var lines []string
+		for i := 0; i < len(oldArray) || i < len(newArray); i++ {
+			itemPath := fmt.Sprintf("%s[%d]", keyPath, i)
+			switch {
+			case i >= len(oldArray):
+				lines = append(lines, fmt.Sprintf("+ %s: %s", itemPath, plistDiffValue(newArray[i])))
+			case i >= len(newArray):
+				lines = append(lines, fmt.Sprintf("- %s: %s", itemPath, plistDiffValue(oldArray[i])))
+			default:
+				lines = append(lines, plistDiff(itemPath, oldArray[i], newArray[i])...)
+			}
+		}
+		return lines
+	}
+	if reflect.DeepEqual(old, new) {
+		return nil
+	}
+	return []string{fmt.Sprintf("~ %s: %s => %s", keyPath, plistDiffValue(old), plistDiffValue(new))}
+}
+
+func plistDiffItem(itemPath string, oldDict map[string]interface{}, newDict map[string]interface{}, key string) []string {
+	oldValue, inOld := oldDict[key]
+	newValue, inNew := newDict[key]
+	switch {
+	case !inOld:
+		return []string{fmt.Sprintf("+ %s: %s", itemPath, plistDiffValue(newValue))}
+	case !inNew:
+		return []string{fmt.Sprintf("- %s: %s", itemPath, plistDiffValue(oldValue))}
+	default:
+		return plistDiff(itemPath, oldValue, newValue)
+	}
+}
+
+func plistDiffPath(keyPath string, key string) string {
+	if keyPath == "" {
+		return key
+	}
+	return keyPath + "." + key
+}
+
+func plistDiffValue(v interface{}) string {
+	var s string
+	switch tv := v.(type) {
+	case string:
+		s = fmt.Sprintf("%q", tv)
+	case []byte:
+		return fmt.Sprintf("<data %d bytes>", len(tv))
+	case time.Time:
+		s = tv.UTC().Format(time.RFC3339)
+	case map[string]interface{}:
+		return fmt.Sprintf("<dict %d keys>", len(tv))
+	case []interface{}:
+		return fmt.Sprintf("<array %d items>", len(tv))
+	default:
+		s = fmt.Sprintf("%v", tv)
+	}
+	if len(s) > mdmProfileDiffMaxValueChars {
+		s = s[:mdmProfileDiffMaxValueChars] + "…"
+	}
+	return s
+}
+
+// mdmProfileDiff returns a human readable summary of the differences between
+// two configuration profiles.
+func mdmProfileDiff(old *mdmProfilePayload, new *mdmProfilePayload) string {
+	lines := plistDiff("", old.dict, new.dict)
+	if len(lines) > mdmProfileDiffMaxLines {
+		more := len(lines) - mdmProfileDiffMaxLines
+		lines = append(lines[:mdmProfileDiffMaxLines], fmt.Sprintf("… %d more change(s)", more))
+	}
+	var b bytes.Buffer
+	for _, line := range lines {
+		b.WriteString(line)
+		b.WriteString("\n")
+	}
+	return b.String()
+}
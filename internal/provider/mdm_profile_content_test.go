@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func testMDMProfileContent(payloadType string, displayName string, extra string) string {
+	return fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+  <key>PayloadContent</key>
+  <array>
+    <dict>
+      <key>PayloadType</key>
+      <string>com.apple.screensaver</string>
+      <key>idleTime</key>
+      <integer>600</integer>%s
+    </dict>
+  </array>
+  <key>PayloadDisplayName</key>
+  <string>%s</string>
+  <key>PayloadIdentifier</key>
+  <string>com.example.screensaver</string>
+  <key>PayloadType</key>
+  <string>%s</string>
+  <key>PayloadUUID</key>
+  <string>1B6B2C53-8B6A-4B47-A0D2-1B0C2F2E3A4D</string>
+  <key>PayloadVersion</key>
+  <integer>1</integer>
+</dict>
+</plist>
+`, extra, displayName, payloadType)
+}
+
+func TestMDMProfilePayloadWithContent(t *testing.T) {
+	p, err := mdmProfilePayloadWithContent(testMDMProfileContent("Configuration", "Screensaver", ""))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got := p.stringAttr("PayloadIdentifier").ValueString(); got != "com.example.screensaver" {
+		t.Errorf("unexpected PayloadIdentifier %q", got)
+	}
+	if got := p.stringAttr("PayloadUUID").ValueString(); got != "1B6B2C53-8B6A-4B47-A0D2-1B0C2F2E3A4D" {
+		t.Errorf("unexpected PayloadUUID %q", got)
+	}
+	if got := p.stringAttr("PayloadDisplayName").ValueString(); got != "Screensaver" {
+		t.Errorf("unexpected PayloadDisplayName %q", got)
+	}
+	if !mdmProfilePayloadWithSource(p.source()).equal(p) {
+		t.Errorf("source round trip failed")
+	}
+}
+
+func TestMDMProfilePayloadWithContentFile(t *testing.T) {
+	fp := filepath.Join(t.TempDir(), "screensaver.mobileconfig")
+	content := testMDMProfileContent("Configuration", "Screensaver", "")
+	if err := os.WriteFile(fp, []byte(content), 0o644); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	p, err := mdmProfilePayloadWithContent(fp)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if string(p.raw) != content {
+		t.Errorf("unexpected raw content")
+	}
+}
+
+func TestMDMProfilePayloadWithContentErrors(t *testing.T) {
+	cases := []struct {
+		name    string
+		content string
+		err     string
+	}{
+		{"missing file", "/yolo/fomo.mobileconfig", "not a property list, and not a readable file"},
+		{"invalid plist", "<plist><dict><key>yolo</key></plist>", "invalid property list"},
+		{"payload type", testMDMProfileContent("com.apple.screensaver", "Screensaver", ""), "PayloadType must be Configuration"},
+		{"payload identifier", strings.Replace(testMDMProfileContent("Configuration", "Screensaver", ""), "com.example.screensaver", "", 1), "missing PayloadIdentifier"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			_, err := mdmProfilePayloadWithContent(c.content)
+			if err == nil || !strings.Contains(err.Error(), c.err) {
+				t.Fatalf("expected error %q, got %v", c.err, err)
+			}
+		})
+	}
+}
+
+func TestMDMProfilePayloadWithSourceInvalid(t *testing.T) {
+	if p := mdmProfilePayloadWithSource("not base64!"); p != nil {
+		t.Errorf("expected nil payload")
+	}
+	if p := mdmProfilePayloadWithSource(""); p != nil {
+		t.Errorf("expected nil payload")
+	}
+	if v := (*mdmProfilePayload)(nil).stringAttr("PayloadUUID"); !v.IsNull() {
+		t.Errorf("expected null value")
+	}
+}
+
+func TestMDMProfilePayloadEqual(t *testing.T) {
+	p1, _ := mdmProfilePayloadWithContent(testMDMProfileContent("Configuration", "Screensaver", ""))
+	// same keys and values, different serialization
+	p2, _ := mdmProfilePayloadWithContent(strings.ReplaceAll(testMDMProfileContent("Configuration", "Screensaver", ""), "  ", "\t"))
+	p3, _ := mdmProfilePayloadWithContent(testMDMProfileContent("Configuration", "Screen saver", ""))
+	if !p1.equal(p2) {
+		t.Errorf("expected equal payloads")
+	}
+	if p1.equal(p3) {
+		t.Errorf("expected different payloads")
+	}
+}
+
+func TestMDMProfileDiff(t *testing.T) {
+	p1, _ := mdmProfilePayloadWithContent(testMDMProfileContent("Configuration", "Screensaver", ""))
+	p2, _ := mdmProfilePayloadWithContent(testMDMProfileContent(
+		"Configuration",
+		"Screen saver",
+		"\n      <key>askForPassword</key>\n      <true/>",
+	))
+	got := mdmProfileDiff(p1, p2)
+	want := "+ PayloadContent[0].askForPassword: true\n" +
+		"~ PayloadDisplayName: \"Screensaver\" => \"Screen saver\"\n"
+	if got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+	if got := mdmProfileDiff(p2, p1); !strings.Contains(got, "- PayloadContent[0].askForPassword: true\n") {
+		t.Errorf("missing removed key in:\n%s", got)
+	}
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -13,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/zentralopensource/goztl"
@@ -21,6 +24,9 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &MDMProfileResource{}
 var _ resource.ResourceWithImportState = &MDMProfileResource{}
+var _ resource.ResourceWithConfigValidators = &MDMProfileResource{}
+var _ resource.ResourceWithModifyPlan = &MDMProfileResource{}
+var _ resource.ResourceWithValidateConfig = &MDMProfileResource{}
 
 func NewMDMProfileResource() resource.Resource {
 	return &MDMProfileResource{}
@@ -55,9 +61,33 @@ func (r *MDMProfileResource) Schema(ctx context.Context, req resource.SchemaRequ
 				Required:            true,
 			},
 			"source": schema.StringAttribute{
-				Description:         "The configuration profile, serialized and base 64 encoded.",
-				MarkdownDescription: "The configuration profile, serialized and base 64 encoded.",
-				Required:            true,
+				Description:         "The configuration profile, serialized and base 64 encoded. Exactly one of source or content must be set.",
+				MarkdownDescription: "The configuration profile, serialized and base 64 encoded. Exactly one of `source` or `content` must be set.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("content")),
+				},
+			},
+			"content": schema.StringAttribute{
+				Description:         "The configuration profile, as a XML property list, or the path to the configuration profile file. The PayloadType of the profile must be Configuration. Exactly one of source or content must be set.",
+				MarkdownDescription: "The configuration profile, as a XML property list, or the path to the configuration profile file. The `PayloadType` of the profile must be `Configuration`. Exactly one of `source` or `content` must be set.",
+				Optional:            true,
+			},
+			"payload_identifier": schema.StringAttribute{
+				Description:         "The PayloadIdentifier of the configuration profile.",
+				MarkdownDescription: "The `PayloadIdentifier` of the configuration profile.",
+				Computed:            true,
+			},
+			"payload_uuid": schema.StringAttribute{
+				Description:         "The PayloadUUID of the configuration profile.",
+				MarkdownDescription: "The `PayloadUUID` of the configuration profile.",
+				Computed:            true,
+			},
+			"payload_display_name": schema.StringAttribute{
+				Description:         "The PayloadDisplayName of the configuration profile.",
+				MarkdownDescription: "The `PayloadDisplayName` of the configuration profile.",
+				Computed:            true,
 			},
 			"ios": schema.BoolAttribute{
 				Description:         "Toggles the installation of the profile on iOS devices.",
@@ -195,6 +225,10 @@ func (r *MDMProfileResource) Schema(ctx context.Context, req resource.SchemaRequ
 	}
 }
 
+func (r *MDMProfileResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return mdmArtifactVersionConfigValidators()
+}
+
 func (r *MDMProfileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -215,6 +249,112 @@ func (r *MDMProfileResource) Configure(ctx context.Context, req resource.Configu
 	r.client = client
 }
 
+func (r *MDMProfileResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data mdmProfile
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Content.IsNull() || data.Content.IsUnknown() {
+		return
+	}
+	if _, err := mdmProfilePayloadWithContent(data.Content.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("content"),
+			"Invalid MDM profile content",
+			err.Error(),
+		)
+	}
+}
+
+// ModifyPlan sets the source and the payload attributes using the content.
+// The source is only updated if the configuration profile keys or values have
+// changed, and a summary of the changes is added to the plan as a warning.
+func (r *MDMProfileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan mdmProfile
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	var state *mdmProfile
+	if !req.State.Raw.IsNull() {
+		state = &mdmProfile{}
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var payload *mdmProfilePayload
+	attrPath := path.Root("source")
+	if !plan.Content.IsNull() {
+		attrPath = path.Root("content")
+		if plan.Content.IsUnknown() {
+			plan.Source = types.StringUnknown()
+		} else {
+			var err error
+			payload, err = mdmProfilePayloadWithContent(plan.Content.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(attrPath, "Invalid MDM profile content", err.Error())
+				return
+			}
+			plan.Source = types.StringValue(payload.source())
+		}
+	} else if !plan.Source.IsUnknown() {
+		payload = mdmProfilePayloadWithSource(plan.Source.ValueString())
+	}
+
+	if plan.Source.IsUnknown() {
+		plan.PayloadIdentifier = types.StringUnknown()
+		plan.PayloadUUID = types.StringUnknown()
+		plan.PayloadDisplayName = types.StringUnknown()
+	} else {
+		plan.PayloadIdentifier = payload.stringAttr("PayloadIdentifier")
+		plan.PayloadUUID = payload.stringAttr("PayloadUUID")
+		plan.PayloadDisplayName = payload.stringAttr("PayloadDisplayName")
+	}
+
+	if state != nil && payload != nil {
+		if statePayload := mdmProfilePayloadWithSource(state.Source.ValueString()); statePayload != nil {
+			if payload.equal(statePayload) {
+				if !plan.Content.IsNull() {
+					// Serialization changes only
+					plan.Source = state.Source
+				}
+			} else {
+				resp.Diagnostics.AddAttributeWarning(
+					attrPath,
+					"MDM profile changes",
+					fmt.Sprintf(
+						"The configuration profile %s will be updated:\n\n%s",
+						plan.PayloadIdentifier.ValueString(),
+						mdmProfileDiff(statePayload, payload),
+					),
+				)
+			}
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+}
+
+// mdmProfileForStateWithPlan returns the state of a profile after a create or
+// an update. The planned source is kept if the configuration profile returned
+// by the API has the same keys and values.
+func mdmProfileForStateWithPlan(mp *goztl.MDMProfile, plan mdmProfile) mdmProfile {
+	data := mdmProfileForState(mp, plan.Content)
+	if data.Source != plan.Source {
+		planPayload := mdmProfilePayloadWithSource(plan.Source.ValueString())
+		if planPayload != nil && planPayload.equal(mdmProfilePayloadWithSource(data.Source.ValueString())) {
+			data.Source = plan.Source
+		}
+	}
+	return data
+}
+
 func (r *MDMProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data mdmProfile
 
@@ -237,7 +377,7 @@ func (r *MDMProfileResource) Create(ctx context.Context, req resource.CreateRequ
 	tflog.Trace(ctx, "created a MDM profile")
 
 	// Save data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, mdmProfileForState(ztlMP))...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, mdmProfileForStateWithPlan(ztlMP, data))...)
 }
 
 func (r *MDMProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -250,8 +390,13 @@ func (r *MDMProfileResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	ztlMP, _, err := r.client.MDMProfiles.GetByID(ctx, data.ID.ValueString())
+	ztlMP, ztlResp, err := r.client.MDMProfiles.GetByID(ctx, data.ID.ValueString())
 	if err != nil {
+		if isNotFound(ztlResp, err) {
+			tflog.Warn(ctx, "MDM profile not found, removing it from the state", map[string]interface{}{"id": data.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Client error",
 			fmt.Sprintf("Unable to read MDM profile %s, got error: %s", data.ID.ValueString(), err),
@@ -262,7 +407,7 @@ func (r *MDMProfileResource) Read(ctx context.Context, req resource.ReadRequest,
 	tflog.Trace(ctx, "read a MDM profile")
 
 	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, mdmProfileForState(ztlMP))...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, mdmProfileForState(ztlMP, data.Content))...)
 }
 
 func (r *MDMProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -287,7 +432,7 @@ func (r *MDMProfileResource) Update(ctx context.Context, req resource.UpdateRequ
 	tflog.Trace(ctx, "updated a MDM profile")
 
 	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, mdmProfileForState(ztlMP))...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, mdmProfileForStateWithPlan(ztlMP, data))...)
 }
 
 func (r *MDMProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -123,6 +124,34 @@ func TestAccMDMProfileResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// Invalid content
+			{
+				Config:      testAccMDMProfileResourceConfigContent(name, "com.apple.screensaver"),
+				ExpectError: regexp.MustCompile(`PayloadType must be Configuration`),
+			},
+			// Update with content and Read
+			{
+				Config: testAccMDMProfileResourceConfigContent(name, "Configuration"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						resourceName, "source"),
+					resource.TestCheckResourceAttr(
+						resourceName, "payload_identifier", "com.example.screensaver"),
+					resource.TestCheckResourceAttr(
+						resourceName, "payload_uuid", "1B6B2C53-8B6A-4B47-A0D2-1B0C2F2E3A4D"),
+					resource.TestCheckResourceAttr(
+						resourceName, "payload_display_name", "Screensaver"),
+					resource.TestCheckResourceAttr(
+						resourceName, "version", "3"),
+				),
+			},
+			// ImportState
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content"},
+			},
 		},
 	})
 }
@@ -175,3 +204,40 @@ resource "zentral_mdm_profile" "test" {
 }
 `, name)
 }
+
+func testAccMDMProfileResourceConfigContent(name string, payloadType string) string {
+	return fmt.Sprintf(`
+resource "zentral_mdm_artifact" "test" {
+  name      = %[1]q
+  type      = "Profile"
+  channel   = "Device"
+  platforms = ["macOS"]
+}
+
+resource "zentral_mdm_profile" "test" {
+  artifact_id = zentral_mdm_artifact.test.id
+  content     = <<-EOT
+    <?xml version="1.0" encoding="UTF-8"?>
+    <!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+    <plist version="1.0">
+    <dict>
+      <key>PayloadContent</key>
+      <array/>
+      <key>PayloadDisplayName</key>
+      <string>Screensaver</string>
+      <key>PayloadIdentifier</key>
+      <string>com.example.screensaver</string>
+      <key>PayloadType</key>
+      <string>%[2]s</string>
+      <key>PayloadUUID</key>
+      <string>1B6B2C53-8B6A-4B47-A0D2-1B0C2F2E3A4D</string>
+      <key>PayloadVersion</key>
+      <integer>1</integer>
+    </dict>
+    </plist>
+  EOT
+  version     = 3
+  macos       = true
+}
+`, name, payloadType)
+}
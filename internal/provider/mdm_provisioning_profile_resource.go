@@ -21,6 +21,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &MDMProvisioningProfileResource{}
 var _ resource.ResourceWithImportState = &MDMProvisioningProfileResource{}
+var _ resource.ResourceWithConfigValidators = &MDMProvisioningProfileResource{}
 
 func NewMDMProvisioningProfileResource() resource.Resource {
 	return &MDMProvisioningProfileResource{}
@@ -195,6 +196,10 @@ func (r *MDMProvisioningProfileResource) Schema(ctx context.Context, req resourc
 	}
 }
 
+func (r *MDMProvisioningProfileResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return mdmArtifactVersionConfigValidators()
+}
+
 func (r *MDMProvisioningProfileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -250,8 +255,13 @@ func (r *MDMProvisioningProfileResource) Read(ctx context.Context, req resource.
 		return
 	}
 
-	ztlMPP, _, err := r.client.MDMProvisioningProfiles.GetByID(ctx, data.ID.ValueString())
+	ztlMPP, ztlResp, err := r.client.MDMProvisioningProfiles.GetByID(ctx, data.ID.ValueString())
 	if err != nil {
+		if isNotFound(ztlResp, err) {
+			tflog.Warn(ctx, "MDM provisioning profile not found, removing it from the state", map[string]interface{}{"id": data.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Client error",
 			fmt.Sprintf("Unable to read MDM provisioning profile %s, got error: %s", data.ID.ValueString(), err),
@@ -1,18 +1,127 @@
 package provider
 
 import (
+	"context"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/zentralopensource/goztl"
 )
 
+// mdmPushCertificatesPath is the Zentral API endpoint of the MDM push certificates.
+const mdmPushCertificatesPath = "mdm/push_certificates/"
+
+// mdmPushCertificateExpiryWarningDelay is the delay before the expiry of a
+// push certificate below which a warning is emitted.
+const mdmPushCertificateExpiryWarningDelay = 30 * 24 * time.Hour
+
+// The APNs topic is the user ID of the push certificate subject.
+var oidUserID = asn1.ObjectIdentifier{0, 9, 2342, 19200300, 100, 1, 1}
+
 type mdmPushCertificate struct {
 	ID              types.Int64  `tfsdk:"id"`
 	ProvisioningUID types.String `tfsdk:"provisioning_uid"`
 	Name            types.String `tfsdk:"name"`
 	Topic           types.String `tfsdk:"topic"`
+	NotBefore       types.String `tfsdk:"not_before"`
+	NotAfter        types.String `tfsdk:"not_after"`
 	Certificate     types.String `tfsdk:"certificate"`
 }
 
+// parseMDMPushCertificate returns the first certificate of a PEM bundle.
+func parseMDMPushCertificate(certPEM string) (*x509.Certificate, error) {
+	block, _ := pem.Decode([]byte(certPEM))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, errors.New("no PEM encoded certificate found")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
+// mdmPushCertificateTopic returns the APNs topic of a push certificate, or an
+// empty string if the certificate subject does not have a user ID.
+func mdmPushCertificateTopic(cert *x509.Certificate) string {
+	for _, atv := range cert.Subject.Names {
+		if atv.Type.Equal(oidUserID) {
+			if topic, ok := atv.Value.(string); ok {
+				return topic
+			}
+		}
+	}
+	return ""
+}
+
+// mdmPushCertificateValidity returns the topic, and the validity bounds of a
+// push certificate as RFC 3339 timestamps. The values are null if the
+// certificate cannot be parsed.
+func mdmPushCertificateValidity(certPEM types.String) (types.String, types.String, types.String) {
+	topic, notBefore, notAfter := types.StringNull(), types.StringNull(), types.StringNull()
+	if certPEM.IsNull() || certPEM.IsUnknown() {
+		return topic, notBefore, notAfter
+	}
+	cert, err := parseMDMPushCertificate(certPEM.ValueString())
+	if err != nil {
+		return topic, notBefore, notAfter
+	}
+	if t := mdmPushCertificateTopic(cert); t != "" {
+		topic = types.StringValue(t)
+	}
+	notBefore = types.StringValue(cert.NotBefore.UTC().Format(time.RFC3339))
+	notAfter = types.StringValue(cert.NotAfter.UTC().Format(time.RFC3339))
+	return topic, notBefore, notAfter
+}
+
+// mdmPushCertificateExpiryDiagnostics returns a warning if the push
+// certificate has expired, or expires in less than 30 days.
+func mdmPushCertificateExpiryDiagnostics(name types.String, notAfter types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if notAfter.IsNull() || notAfter.IsUnknown() {
+		return diags
+	}
+	expiry, err := time.Parse(time.RFC3339, notAfter.ValueString())
+	if err != nil {
+		return diags
+	}
+	remaining := time.Until(expiry)
+	if remaining <= 0 {
+		diags.AddWarning(
+			"MDM push certificate expired",
+			fmt.Sprintf("The MDM push certificate %q expired on %s. The enrolled devices cannot be reached until it is renewed.", name.ValueString(), notAfter.ValueString()),
+		)
+	} else if remaining < mdmPushCertificateExpiryWarningDelay {
+		diags.AddWarning(
+			"MDM push certificate expiring soon",
+			fmt.Sprintf("The MDM push certificate %q expires on %s, in %d day(s). It must be renewed before it expires.", name.ValueString(), notAfter.ValueString(), int(remaining.Hours()/24)),
+		)
+	}
+	return diags
+}
+
+// mdmPushCertificateTopicChangeDiagnostics returns a warning if the topic of
+// the push certificate has changed since the prior state.
+func mdmPushCertificateTopicChangeDiagnostics(name types.String, priorTopic types.String, topic types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if priorTopic.IsNull() || priorTopic.IsUnknown() || topic.IsNull() || topic.Equal(priorTopic) {
+		return diags
+	}
+	diags.AddWarning(
+		"MDM push certificate topic change",
+		fmt.Sprintf(
+			"The APNS topic of the push certificate %q has changed from %s to %s. "+
+				"The devices enrolled with the previous topic are not reachable anymore, and have to be re-enrolled. "+
+				"Push certificates must be renewed using the Apple ID used to create them.",
+			name.ValueString(), priorTopic.ValueString(), topic.ValueString(),
+		),
+	)
+	return diags
+}
+
 func mdmPushCertificateForState(mpc *goztl.MDMPushCertificate) mdmPushCertificate {
 	var provisioningUID types.String
 	if mpc.ProvisioningUID != nil {
@@ -21,13 +130,6 @@ func mdmPushCertificateForState(mpc *goztl.MDMPushCertificate) mdmPushCertificat
 		provisioningUID = types.StringNull()
 	}
 
-	var topic types.String
-	if mpc.Topic != nil {
-		topic = types.StringValue(*mpc.Topic)
-	} else {
-		topic = types.StringNull()
-	}
-
 	var certificate types.String
 	if mpc.Certificate != nil {
 		certificate = types.StringValue(*mpc.Certificate)
@@ -35,11 +137,67 @@ func mdmPushCertificateForState(mpc *goztl.MDMPushCertificate) mdmPushCertificat
 		certificate = types.StringNull()
 	}
 
+	topic, notBefore, notAfter := mdmPushCertificateValidity(certificate)
+	if mpc.Topic != nil {
+		topic = types.StringValue(*mpc.Topic)
+	}
+
 	return mdmPushCertificate{
 		ID:              types.Int64Value(int64(mpc.ID)),
 		ProvisioningUID: provisioningUID,
 		Name:            types.StringValue(mpc.Name),
 		Topic:           topic,
+		NotBefore:       notBefore,
+		NotAfter:        notAfter,
 		Certificate:     certificate,
 	}
 }
+
+// The MDM push certificate requests are sent directly, because the push
+// certificates cannot be created with the goztl client. Only the name is sent.
+// The certificate and its private key are managed in Zentral, and are
+// read-only in the API responses.
+
+type mdmPushCertificateRequest struct {
+	Name string `json:"name"`
+}
+
+func mdmPushCertificateRequestWithState(data mdmPushCertificate) *mdmPushCertificateRequest {
+	return &mdmPushCertificateRequest{
+		Name: data.Name.ValueString(),
+	}
+}
+
+func createMDMPushCertificate(ctx context.Context, c *goztl.Client, createRequest *mdmPushCertificateRequest) (*goztl.MDMPushCertificate, *goztl.Response, error) {
+	req, err := c.NewRequest(ctx, http.MethodPost, mdmPushCertificatesPath, createRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+	mpc := new(goztl.MDMPushCertificate)
+	resp, err := c.Do(ctx, req, mpc)
+	if err != nil {
+		return nil, resp, err
+	}
+	return mpc, resp, nil
+}
+
+func updateMDMPushCertificate(ctx context.Context, c *goztl.Client, mpcID int, updateRequest *mdmPushCertificateRequest) (*goztl.MDMPushCertificate, *goztl.Response, error) {
+	req, err := c.NewRequest(ctx, http.MethodPatch, fmt.Sprintf("%s%d/", mdmPushCertificatesPath, mpcID), updateRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+	mpc := new(goztl.MDMPushCertificate)
+	resp, err := c.Do(ctx, req, mpc)
+	if err != nil {
+		return nil, resp, err
+	}
+	return mpc, resp, nil
+}
+
+func deleteMDMPushCertificate(ctx context.Context, c *goztl.Client, mpcID int) (*goztl.Response, error) {
+	req, err := c.NewRequest(ctx, http.MethodDelete, fmt.Sprintf("%s%d/", mdmPushCertificatesPath, mpcID), nil)
+	if err != nil {
+		return nil, err
+	}
+	return c.Do(ctx, req, nil)
+}
@@ -51,6 +51,16 @@ func (d *MDMPushCertificateDataSource) Schema(ctx context.Context, req datasourc
 				MarkdownDescription: "APNS topic of the push certificate.",
 				Computed:            true,
 			},
+			"not_before": schema.StringAttribute{
+				Description:         "Start of the validity period of the push certificate, in RFC 3339 format.",
+				MarkdownDescription: "Start of the validity period of the push certificate, in RFC 3339 format.",
+				Computed:            true,
+			},
+			"not_after": schema.StringAttribute{
+				Description:         "End of the validity period of the push certificate, in RFC 3339 format.",
+				MarkdownDescription: "End of the validity period of the push certificate, in RFC 3339 format.",
+				Computed:            true,
+			},
 			"certificate": schema.StringAttribute{
 				Description:         "Push certificate in PEM form.",
 				MarkdownDescription: "Push certificate in `PEM` form.",
@@ -132,6 +142,8 @@ func (d *MDMPushCertificateDataSource) Read(ctx context.Context, req datasource.
 	}
 
 	if ztlMPC != nil {
-		resp.Diagnostics.Append(resp.State.Set(ctx, mdmPushCertificateForState(ztlMPC))...)
+		state := mdmPushCertificateForState(ztlMPC)
+		resp.Diagnostics.Append(mdmPushCertificateExpiryDiagnostics(state.Name, state.NotAfter)...)
+		resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 	}
 }
@@ -0,0 +1,232 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/zentralopensource/goztl"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &MDMPushCertificateResource{}
+var _ resource.ResourceWithImportState = &MDMPushCertificateResource{}
+
+func NewMDMPushCertificateResource() resource.Resource {
+	return &MDMPushCertificateResource{}
+}
+
+// MDMPushCertificateResource defines the resource implementation.
+type MDMPushCertificateResource struct {
+	client *goztl.Client
+}
+
+func (r *MDMPushCertificateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mdm_push_certificate"
+}
+
+func (r *MDMPushCertificateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages MDM push certificates. The APNs certificates and their private keys are managed in Zentral.",
+		MarkdownDescription: "The resource `zentral_mdm_push_certificate` manages MDM push certificates. The APNs certificates and their private keys are managed in Zentral.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description:         "ID of the push certificate.",
+				MarkdownDescription: "`ID` of the push certificate.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"provisioning_uid": schema.StringAttribute{
+				Description:         "Provisioning UID of the push certificate.",
+				MarkdownDescription: "Provisioning `UID` of the push certificate.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description:         "Name of the push certificate.",
+				MarkdownDescription: "Name of the push certificate.",
+				Required:            true,
+			},
+			"topic": schema.StringAttribute{
+				Description:         "APNS topic of the push certificate.",
+				MarkdownDescription: "APNS topic of the push certificate.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"not_before": schema.StringAttribute{
+				Description:         "Start of the validity period of the push certificate, in RFC 3339 format.",
+				MarkdownDescription: "Start of the validity period of the push certificate, in RFC 3339 format.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"not_after": schema.StringAttribute{
+				Description:         "End of the validity period of the push certificate, in RFC 3339 format.",
+				MarkdownDescription: "End of the validity period of the push certificate, in RFC 3339 format.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"certificate": schema.StringAttribute{
+				Description:         "Push certificate in PEM form, signed by Apple.",
+				MarkdownDescription: "Push certificate in `PEM` form, signed by Apple.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *MDMPushCertificateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*goztl.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *goztl.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *MDMPushCertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data mdmPushCertificate
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ztlMPC, _, err := createMDMPushCertificate(ctx, r.client, mdmPushCertificateRequestWithState(data))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to create MDM push certificate, got error: %s", err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "created a MDM push certificate")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, mdmPushCertificateForState(ztlMPC))...)
+}
+
+func (r *MDMPushCertificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data mdmPushCertificate
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ztlMPC, ztlResp, err := r.client.MDMPushCertificates.GetByID(ctx, int(data.ID.ValueInt64()))
+	if err != nil {
+		if isNotFound(ztlResp, err) {
+			tflog.Warn(ctx, "MDM push certificate not found, removing it from the state", map[string]interface{}{"id": data.ID.ValueInt64()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client error",
+			fmt.Sprintf("Unable to read MDM push certificate %d, got error: %s", data.ID.ValueInt64(), err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "read a MDM push certificate")
+
+	state := mdmPushCertificateForState(ztlMPC)
+	resp.Diagnostics.Append(mdmPushCertificateTopicChangeDiagnostics(state.Name, data.Topic, state.Topic)...)
+	resp.Diagnostics.Append(mdmPushCertificateExpiryDiagnostics(state.Name, state.NotAfter)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *MDMPushCertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data mdmPushCertificate
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ztlMPC, _, err := updateMDMPushCertificate(ctx, r.client, int(data.ID.ValueInt64()), mdmPushCertificateRequestWithState(data))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to update MDM push certificate %d, got error: %s", data.ID.ValueInt64(), err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "updated a MDM push certificate")
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, mdmPushCertificateForState(ztlMPC))...)
+}
+
+func (r *MDMPushCertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data mdmPushCertificate
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := deleteMDMPushCertificate(ctx, r.client, int(data.ID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to delete MDM push certificate %d, got error: %s", data.ID.ValueInt64(), err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a MDM push certificate")
+}
+
+func (r *MDMPushCertificateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resourceImportStateZentralIDOrName(ctx, "MDM push certificate", req, resp, func(ctx context.Context, name string) (int, error) {
+		obj, _, err := r.client.MDMPushCertificates.GetByName(ctx, name)
+		if err != nil {
+			return 0, err
+		}
+		if obj == nil {
+			return 0, errImportKeyNotFound
+		}
+		return obj.ID, nil
+	})
+}
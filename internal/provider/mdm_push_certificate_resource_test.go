@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/zentralopensource/goztl"
+)
+
+func TestAccMDMPushCertificateResource(t *testing.T) {
+	firstName := acctest.RandString(12)
+	secondName := acctest.RandString(12)
+	resourceName := "zentral_mdm_push_certificate.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccMDMPushCertificateResourceConfig(firstName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						resourceName, "name", firstName),
+					resource.TestCheckNoResourceAttr(
+						resourceName, "certificate"),
+					resource.TestCheckNoResourceAttr(
+						resourceName, "topic"),
+					resource.TestCheckNoResourceAttr(
+						resourceName, "not_before"),
+					resource.TestCheckNoResourceAttr(
+						resourceName, "not_after"),
+				),
+			},
+			// ImportState
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read
+			{
+				Config: testAccMDMPushCertificateResourceConfig(secondName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						resourceName, "name", secondName),
+					resource.TestCheckNoResourceAttr(
+						resourceName, "certificate"),
+				),
+			},
+			// ImportState by name
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     "name:" + secondName,
+				ImportStateVerify: true,
+			},
+			// Deleted outside of Terraform
+			{
+				Config: testAccMDMPushCertificateResourceConfig(secondName),
+				Check: testAccCheckResourceDisappears(
+					resourceName,
+					func(ctx context.Context, c *goztl.Client, id string) (*goztl.Response, error) {
+						ztlID, err := strconv.Atoi(id)
+						if err != nil {
+							return nil, err
+						}
+						return deleteMDMPushCertificate(ctx, c, ztlID)
+					},
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccMDMPushCertificateResourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "zentral_mdm_push_certificate" "test" {
+  name = %[1]q
+}
+`, name)
+}
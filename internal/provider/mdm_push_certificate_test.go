@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testMDMPushCertificatePEM returns a self-signed push certificate in PEM form.
+func testMDMPushCertificatePEM(t *testing.T, topic string, notAfter time.Time) string {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("could not generate key: %s", err)
+	}
+	subject := pkix.Name{CommonName: "APSP:" + topic}
+	if topic != "" {
+		subject.ExtraNames = []pkix.AttributeTypeAndValue{{Type: oidUserID, Value: topic}}
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      subject,
+		NotBefore:    notAfter.AddDate(-1, 0, 0),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("could not create certificate: %s", err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func TestMDMPushCertificateValidity(t *testing.T) {
+	notAfter := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	certPEM := testMDMPushCertificatePEM(t, "com.apple.mgmt.External.2", notAfter)
+	topic, nb, na := mdmPushCertificateValidity(types.StringValue(certPEM))
+	if topic.ValueString() != "com.apple.mgmt.External.2" {
+		t.Errorf("unexpected topic %s", topic)
+	}
+	if nb.ValueString() != "2029-01-02T03:04:05Z" {
+		t.Errorf("unexpected not_before %s", nb)
+	}
+	if na.ValueString() != "2030-01-02T03:04:05Z" {
+		t.Errorf("unexpected not_after %s", na)
+	}
+}
+
+func TestMDMPushCertificateExpiryDiagnostics(t *testing.T) {
+	name := types.StringValue("Default")
+	cases := []struct {
+		notAfter types.String
+		summary  string
+	}{
+		{types.StringNull(), ""},
+		{types.StringValue(time.Now().AddDate(0, 3, 0).UTC().Format(time.RFC3339)), ""},
+		{types.StringValue(time.Now().AddDate(0, 0, 10).UTC().Format(time.RFC3339)), "MDM push certificate expiring soon"},
+		{types.StringValue(time.Now().AddDate(0, 0, -1).UTC().Format(time.RFC3339)), "MDM push certificate expired"},
+	}
+	for _, c := range cases {
+		diags := mdmPushCertificateExpiryDiagnostics(name, c.notAfter)
+		if c.summary == "" {
+			if len(diags) != 0 {
+				t.Errorf("%s: unexpected diagnostics %v", c.notAfter, diags)
+			}
+		} else if len(diags.Warnings()) != 1 || diags.Warnings()[0].Summary() != c.summary {
+			t.Errorf("%s: expected warning %q, got %v", c.notAfter, c.summary, diags)
+		}
+	}
+}
+
+func TestMDMPushCertificateTopicChangeDiagnostics(t *testing.T) {
+	name := types.StringValue("Default")
+	cases := []struct {
+		priorTopic types.String
+		topic      types.String
+		warning    bool
+	}{
+		{types.StringNull(), types.StringValue("com.apple.mgmt.External.1"), false},
+		{types.StringValue("com.apple.mgmt.External.1"), types.StringNull(), false},
+		{types.StringValue("com.apple.mgmt.External.1"), types.StringValue("com.apple.mgmt.External.1"), false},
+		{types.StringValue("com.apple.mgmt.External.1"), types.StringValue("com.apple.mgmt.External.2"), true},
+	}
+	for _, c := range cases {
+		diags := mdmPushCertificateTopicChangeDiagnostics(name, c.priorTopic, c.topic)
+		if c.warning {
+			if len(diags.Warnings()) != 1 || diags.Warnings()[0].Summary() != "MDM push certificate topic change" {
+				t.Errorf("%s → %s: expected topic change warning, got %v", c.priorTopic, c.topic, diags)
+			}
+		} else if len(diags) != 0 {
+			t.Errorf("%s → %s: unexpected diagnostics %v", c.priorTopic, c.topic, diags)
+		}
+	}
+}
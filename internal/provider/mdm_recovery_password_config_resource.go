@@ -140,8 +140,13 @@ func (r *MDMRecoveryPasswordConfigResource) Read(ctx context.Context, req resour
 		return
 	}
 
-	ztlMRPC, _, err := r.client.MDMRecoveryPasswordConfigs.GetByID(ctx, int(data.ID.ValueInt64()))
+	ztlMRPC, ztlResp, err := r.client.MDMRecoveryPasswordConfigs.GetByID(ctx, int(data.ID.ValueInt64()))
 	if err != nil {
+		if isNotFound(ztlResp, err) {
+			tflog.Warn(ctx, "MDM recovery password configuration not found, removing it from the state", map[string]interface{}{"id": data.ID.ValueInt64()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Client error",
 			fmt.Sprintf("Unable to read MDM recovery password configuration %d, got error: %s", data.ID.ValueInt64(), err),
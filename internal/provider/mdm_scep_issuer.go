@@ -1,6 +1,11 @@
 package provider
 
 import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/zentralopensource/goztl"
 )
@@ -42,6 +47,22 @@ func mdmSCEPIssuerForState(msi *goztl.MDMSCEPIssuer) mdmSCEPIssuer {
 	}
 }
 
+// mdmSCEPIssuerWithWriteOnlyForState adds the write-only attributes of the
+// backend secrets to the issuer state, using the write-only versions of the
+// prior data.
+func mdmSCEPIssuerWithWriteOnlyForState(ctx context.Context, data mdmSCEPIssuer, prior mdmSCEPIssuer) mdmSCEPIssuer {
+	data.Digicert = objectWithWriteOnlyForState(ctx, data.Digicert, prior.Digicert, "api_token")
+	return data
+}
+
+// mdmSCEPIssuerWithWriteOnlyConfig sets the backend secrets of the issuer plan
+// data to the write-only values of the configuration.
+func mdmSCEPIssuerWithWriteOnlyConfig(ctx context.Context, config tfsdk.Config, data mdmSCEPIssuer) (mdmSCEPIssuer, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	data.Digicert, diags = objectWithWriteOnlyConfig(ctx, config, path.Root("digicert"), data.Digicert, "api_token")
+	return data, diags
+}
+
 func mdmSCEPIssuerRequestWithState(data mdmSCEPIssuer) *goztl.MDMSCEPIssuerRequest {
 	return &goztl.MDMSCEPIssuerRequest{
 		Name:            data.Name.ValueString(),
@@ -138,7 +138,15 @@ func (r *MDMSCEPIssuerResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
-	ztlMSI, _, err := r.client.MDMSCEPIssuers.Create(ctx, mdmSCEPIssuerRequestWithState(data))
+	// Read the write-only secrets from the configuration
+	reqData, diags := mdmSCEPIssuerWithWriteOnlyConfig(ctx, req.Config, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ztlMSI, _, err := r.client.MDMSCEPIssuers.Create(ctx, mdmSCEPIssuerRequestWithState(reqData))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error",
@@ -150,7 +158,7 @@ func (r *MDMSCEPIssuerResource) Create(ctx context.Context, req resource.CreateR
 	tflog.Trace(ctx, "created a MDM SCEP issuer")
 
 	// Save data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, mdmSCEPIssuerForState(ztlMSI))...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, mdmSCEPIssuerWithWriteOnlyForState(ctx, mdmSCEPIssuerForState(ztlMSI), data))...)
 }
 
 func (r *MDMSCEPIssuerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -163,8 +171,13 @@ func (r *MDMSCEPIssuerResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
-	ztlMSI, _, err := r.client.MDMSCEPIssuers.GetByID(ctx, data.ID.ValueString())
+	ztlMSI, ztlResp, err := r.client.MDMSCEPIssuers.GetByID(ctx, data.ID.ValueString())
 	if err != nil {
+		if isNotFound(ztlResp, err) {
+			tflog.Warn(ctx, "SCEP issuer not found, removing it from the state", map[string]interface{}{"id": data.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Client error",
 			fmt.Sprintf("Unable to read SCEP issuer %s, got error: %s", data.ID.ValueString(), err),
@@ -175,7 +188,7 @@ func (r *MDMSCEPIssuerResource) Read(ctx context.Context, req resource.ReadReque
 	tflog.Trace(ctx, "read a MDM SCEP issuer")
 
 	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, mdmSCEPIssuerForState(ztlMSI))...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, mdmSCEPIssuerWithWriteOnlyForState(ctx, mdmSCEPIssuerForState(ztlMSI), data))...)
 }
 
 func (r *MDMSCEPIssuerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -188,7 +201,15 @@ func (r *MDMSCEPIssuerResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
-	ztlMSI, _, err := r.client.MDMSCEPIssuers.Update(ctx, data.ID.ValueString(), mdmSCEPIssuerRequestWithState(data))
+	// Read the write-only secrets from the configuration
+	reqData, diags := mdmSCEPIssuerWithWriteOnlyConfig(ctx, req.Config, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ztlMSI, _, err := r.client.MDMSCEPIssuers.Update(ctx, data.ID.ValueString(), mdmSCEPIssuerRequestWithState(reqData))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error",
@@ -200,7 +221,7 @@ func (r *MDMSCEPIssuerResource) Update(ctx context.Context, req resource.UpdateR
 	tflog.Trace(ctx, "updated a MDM SCEP issuer")
 
 	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, mdmSCEPIssuerForState(ztlMSI))...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, mdmSCEPIssuerWithWriteOnlyForState(ctx, mdmSCEPIssuerForState(ztlMSI), data))...)
 }
 
 func (r *MDMSCEPIssuerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -178,8 +178,13 @@ func (r *MDMSoftwareUpdateEnforcementResource) Read(ctx context.Context, req res
 		return
 	}
 
-	ztlMSUE, _, err := r.client.MDMSoftwareUpdateEnforcements.GetByID(ctx, int(data.ID.ValueInt64()))
+	ztlMSUE, ztlResp, err := r.client.MDMSoftwareUpdateEnforcements.GetByID(ctx, int(data.ID.ValueInt64()))
 	if err != nil {
+		if isNotFound(ztlResp, err) {
+			tflog.Warn(ctx, "MDM software update enforcement not found, removing it from the state", map[string]interface{}{"id": data.ID.ValueInt64()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Client error",
 			fmt.Sprintf("Unable to read MDM software update enforcement %d, got error: %s", data.ID.ValueInt64(), err),
@@ -22,6 +22,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &MDMStoreAppResource{}
 var _ resource.ResourceWithImportState = &MDMStoreAppResource{}
+var _ resource.ResourceWithConfigValidators = &MDMStoreAppResource{}
 
 func NewMDMStoreAppResource() resource.Resource {
 	return &MDMStoreAppResource{}
@@ -252,6 +253,10 @@ func (r *MDMStoreAppResource) Schema(ctx context.Context, req resource.SchemaReq
 	}
 }
 
+func (r *MDMStoreAppResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return mdmArtifactVersionConfigValidators()
+}
+
 func (r *MDMStoreAppResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -307,8 +312,13 @@ func (r *MDMStoreAppResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	ztlMSA, _, err := r.client.MDMStoreApps.GetByID(ctx, data.ID.ValueString())
+	ztlMSA, ztlResp, err := r.client.MDMStoreApps.GetByID(ctx, data.ID.ValueString())
 	if err != nil {
+		if isNotFound(ztlResp, err) {
+			tflog.Warn(ctx, "MDM store app not found, removing it from the state", map[string]interface{}{"id": data.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Client error",
 			fmt.Sprintf("Unable to read MDM store app %s, got error: %s", data.ID.ValueString(), err),
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/zentralopensource/goztl"
 )
@@ -11,6 +12,12 @@ type metaBusinessUnit struct {
 	APIEnrollmentEnabled types.Bool   `tfsdk:"api_enrollment_enabled"`
 }
 
+var metaBusinessUnitAttrTypes = map[string]attr.Type{
+	"id":                     types.Int64Type,
+	"name":                   types.StringType,
+	"api_enrollment_enabled": types.BoolType,
+}
+
 func metaBusinessUnitForState(mbu *goztl.MetaBusinessUnit) metaBusinessUnit {
 	return metaBusinessUnit{
 		ID:                   types.Int64Value(int64(mbu.ID)),
@@ -122,8 +122,13 @@ func (r *MetaBusinessUnitResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	mbu, _, err := r.client.MetaBusinessUnits.GetByID(ctx, int(data.ID.ValueInt64()))
+	mbu, ztlResp, err := r.client.MetaBusinessUnits.GetByID(ctx, int(data.ID.ValueInt64()))
 	if err != nil {
+		if isNotFound(ztlResp, err) {
+			tflog.Warn(ctx, "Meta business unit not found, removing it from the state", map[string]interface{}{"id": data.ID.ValueInt64()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Client error",
 			fmt.Sprintf("Unable to read meta business unit %d, got error: %s", data.ID.ValueInt64(), err),
@@ -191,5 +196,14 @@ func (r *MetaBusinessUnitResource) Delete(ctx context.Context, req resource.Dele
 }
 
 func (r *MetaBusinessUnitResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resourceImportStatePassthroughZentralID(ctx, "meta business unit", req, resp)
+	resourceImportStateZentralIDOrName(ctx, "meta business unit", req, resp, func(ctx context.Context, name string) (int, error) {
+		obj, _, err := r.client.MetaBusinessUnits.GetByName(ctx, name)
+		if err != nil {
+			return 0, err
+		}
+		if obj == nil {
+			return 0, errImportKeyNotFound
+		}
+		return obj.ID, nil
+	})
 }
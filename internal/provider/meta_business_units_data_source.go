@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zentralopensource/goztl"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &MetaBusinessUnitsDataSource{}
+
+func NewMetaBusinessUnitsDataSource() datasource.DataSource {
+	return &MetaBusinessUnitsDataSource{}
+}
+
+// MetaBusinessUnitsDataSource defines the data source implementation.
+type MetaBusinessUnitsDataSource struct {
+	client *goztl.Client
+}
+
+type metaBusinessUnits struct {
+	NamePrefix        types.String `tfsdk:"name_prefix"`
+	NameRegex         types.String `tfsdk:"name_regex"`
+	MetaBusinessUnits types.List   `tfsdk:"meta_business_units"`
+}
+
+func (d *MetaBusinessUnitsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_meta_business_units"
+}
+
+func (d *MetaBusinessUnitsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := nameFilterAttributes("meta business units")
+	attributes["meta_business_units"] = schema.ListNestedAttribute{
+		Description:         "List of the meta business units, sorted by name.",
+		MarkdownDescription: "List of the meta business units, sorted by name.",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.Int64Attribute{
+					Description:         "ID of the meta business unit.",
+					MarkdownDescription: "`ID` of the meta business unit.",
+					Computed:            true,
+				},
+				"name": schema.StringAttribute{
+					Description:         "Name of the meta business unit.",
+					MarkdownDescription: "Name of the meta business unit.",
+					Computed:            true,
+				},
+				"api_enrollment_enabled": schema.BoolAttribute{
+					Description:         "If API enrollments are enabled.",
+					MarkdownDescription: "If API enrollments are enabled.",
+					Computed:            true,
+				},
+			},
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description:         "Allows details of the meta business units to be retrieved, optionally filtered by name.",
+		MarkdownDescription: "The data source `zentral_meta_business_units` allows details of the meta business units to be retrieved, optionally filtered by name.",
+		Attributes:          attributes,
+	}
+}
+
+func (d *MetaBusinessUnitsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*goztl.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *goztl.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *MetaBusinessUnitsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data metaBusinessUnits
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, diags := newNameFilter(data.NamePrefix, data.NameRegex)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (d *MetaBusinessUnitsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data metaBusinessUnits
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nf, diags := newNameFilter(data.NamePrefix, data.NameRegex)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ztlMBUs, err := listAllPages(ctx, d.client.MetaBusinessUnits.List)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to list meta business units, got error: %s", err),
+		)
+		return
+	}
+	sortByName(ztlMBUs, func(mbu goztl.MetaBusinessUnit) string { return mbu.Name })
+
+	mbusForState := make([]metaBusinessUnit, 0)
+	for i := range ztlMBUs {
+		if nf.match(ztlMBUs[i].Name) {
+			mbusForState = append(mbusForState, metaBusinessUnitForState(&ztlMBUs[i]))
+		}
+	}
+
+	data.MetaBusinessUnits, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: metaBusinessUnitAttrTypes}, mbusForState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccMetaBusinessUnitsDataSource(t *testing.T) {
+	prefix := acctest.RandString(12)
+	mbu1ResourceName := "zentral_meta_business_unit.test1"
+	mbu2ResourceName := "zentral_meta_business_unit.test2"
+	dsPrefixResourceName := "data.zentral_meta_business_units.by_prefix"
+	dsRegexResourceName := "data.zentral_meta_business_units.by_regex"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetaBusinessUnitsDataSourceConfig(prefix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Filtered by name prefix, sorted by name
+					resource.TestCheckResourceAttr(
+						dsPrefixResourceName, "meta_business_units.#", "2"),
+					resource.TestCheckResourceAttrPair(
+						dsPrefixResourceName, "meta_business_units.0.id", mbu1ResourceName, "id"),
+					resource.TestCheckResourceAttr(
+						dsPrefixResourceName, "meta_business_units.0.name", prefix+"a"),
+					resource.TestCheckResourceAttr(
+						dsPrefixResourceName, "meta_business_units.0.api_enrollment_enabled", "true"),
+					resource.TestCheckResourceAttrPair(
+						dsPrefixResourceName, "meta_business_units.1.id", mbu2ResourceName, "id"),
+					resource.TestCheckResourceAttr(
+						dsPrefixResourceName, "meta_business_units.1.api_enrollment_enabled", "false"),
+					// Filtered by regex
+					resource.TestCheckResourceAttr(
+						dsRegexResourceName, "meta_business_units.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						dsRegexResourceName, "meta_business_units.0.id", mbu1ResourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMetaBusinessUnitsDataSourceConfig(prefix string) string {
+	return fmt.Sprintf(`
+resource "zentral_meta_business_unit" "test1" {
+  name = "%[1]sa"
+}
+
+resource "zentral_meta_business_unit" "test2" {
+  name                   = "%[1]sb"
+  api_enrollment_enabled = false
+}
+
+data "zentral_meta_business_units" "by_prefix" {
+  name_prefix = %[1]q
+
+  depends_on = [zentral_meta_business_unit.test1, zentral_meta_business_unit.test2]
+}
+
+data "zentral_meta_business_units" "by_regex" {
+  name_regex = "^%[1]sa$"
+
+  depends_on = [zentral_meta_business_unit.test1, zentral_meta_business_unit.test2]
+}
+`, prefix)
+}
@@ -112,8 +112,13 @@ func (r *MonolithCatalogResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
-	ztlMC, _, err := r.client.MonolithCatalogs.GetByID(ctx, int(data.ID.ValueInt64()))
+	ztlMC, ztlResp, err := r.client.MonolithCatalogs.GetByID(ctx, int(data.ID.ValueInt64()))
 	if err != nil {
+		if isNotFound(ztlResp, err) {
+			tflog.Warn(ctx, "Monolith catalog not found, removing it from the state", map[string]interface{}{"id": data.ID.ValueInt64()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Client error",
 			fmt.Sprintf("Unable to read Monolith catalog %d, got error: %s", data.ID.ValueInt64(), err),
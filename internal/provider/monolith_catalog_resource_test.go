@@ -1,11 +1,14 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"strconv"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/zentralopensource/goztl"
 )
 
 func TestAccMonolithCatalogResource(t *testing.T) {
@@ -50,6 +53,21 @@ func TestAccMonolithCatalogResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// Deleted outside of Terraform
+			{
+				Config: testAccMonolithCatalogResourceConfigFull(secondName),
+				Check: testAccCheckResourceDisappears(
+					resourceName,
+					func(ctx context.Context, c *goztl.Client, id string) (*goztl.Response, error) {
+						ztlID, err := strconv.Atoi(id)
+						if err != nil {
+							return nil, err
+						}
+						return c.MonolithCatalogs.Delete(ctx, ztlID)
+					},
+				),
+				ExpectNonEmptyPlan: true,
+			},
 		},
 	})
 }
@@ -112,8 +112,13 @@ func (r *MonolithConditionResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
-	ztlMM, _, err := r.client.MonolithConditions.GetByID(ctx, int(data.ID.ValueInt64()))
+	ztlMM, ztlResp, err := r.client.MonolithConditions.GetByID(ctx, int(data.ID.ValueInt64()))
 	if err != nil {
+		if isNotFound(ztlResp, err) {
+			tflog.Warn(ctx, "Monolith condition not found, removing it from the state", map[string]interface{}{"id": data.ID.ValueInt64()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Client error",
 			fmt.Sprintf("Unable to read Monolith condition %d, got error: %s", data.ID.ValueInt64(), err),
@@ -159,8 +159,13 @@ func (r *MonolithEnrollmentResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
-	ztlME, _, err := r.client.MonolithEnrollments.GetByID(ctx, int(data.ID.ValueInt64()))
+	ztlME, ztlResp, err := r.client.MonolithEnrollments.GetByID(ctx, int(data.ID.ValueInt64()))
 	if err != nil {
+		if isNotFound(ztlResp, err) {
+			tflog.Warn(ctx, "Monolith enrollment not found, removing it from the state", map[string]interface{}{"id": data.ID.ValueInt64()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Client error",
 			fmt.Sprintf("Unable to read Monolith enrollment %d, got error: %s", data.ID.ValueInt64(), err),
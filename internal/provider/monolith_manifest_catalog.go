@@ -1,6 +1,11 @@
 package provider
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/zentralopensource/goztl"
@@ -39,3 +44,19 @@ func monolithManifestCatalogRequestWithState(data monolithManifestCatalog) *gozt
 		TagIDs:     tagIDs,
 	}
 }
+
+// listMonolithManifestCatalogs returns the catalogs of a manifest. The list
+// is filtered by the API, and again on the client side.
+func listMonolithManifestCatalogs(ctx context.Context, c *goztl.Client, manifestID int) ([]goztl.MonolithManifestCatalog, error) {
+	req, err := c.NewRequest(ctx, http.MethodGet, fmt.Sprintf("monolith/manifest_catalogs/?manifest_id=%d", manifestID), nil)
+	if err != nil {
+		return nil, err
+	}
+	var mmcs []goztl.MonolithManifestCatalog
+	if _, err := c.Do(ctx, req, &mmcs); err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(mmcs, func(mmc goztl.MonolithManifestCatalog) bool {
+		return mmc.ManifestID != manifestID
+	}), nil
+}
@@ -120,8 +120,13 @@ func (r *MonolithManifestCatalogResource) Read(ctx context.Context, req resource
 		return
 	}
 
-	ztlMMC, _, err := r.client.MonolithManifestCatalogs.GetByID(ctx, int(data.ID.ValueInt64()))
+	ztlMMC, ztlResp, err := r.client.MonolithManifestCatalogs.GetByID(ctx, int(data.ID.ValueInt64()))
 	if err != nil {
+		if isNotFound(ztlResp, err) {
+			tflog.Warn(ctx, "Monolith manifest catalog not found, removing it from the state", map[string]interface{}{"id": data.ID.ValueInt64()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Client error",
 			fmt.Sprintf("Unable to read Monolith manifest catalog %d, got error: %s", data.ID.ValueInt64(), err),
@@ -183,5 +188,24 @@ func (r *MonolithManifestCatalogResource) Delete(ctx context.Context, req resour
 }
 
 func (r *MonolithManifestCatalogResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resourceImportStatePassthroughZentralID(ctx, "Monolith manifest catalog", req, resp)
+	resourceImportStateZentralIDOrKey(ctx, "Monolith manifest catalog", "<manifest_id>/<catalog_id>", req, resp, func(ctx context.Context, key []string) (int, error) {
+		manifestID, err := parseImportKeyID("manifest_id", key[0])
+		if err != nil {
+			return 0, err
+		}
+		catalogID, err := parseImportKeyID("catalog_id", key[1])
+		if err != nil {
+			return 0, err
+		}
+		mmcs, err := listMonolithManifestCatalogs(ctx, r.client, manifestID)
+		if err != nil {
+			return 0, err
+		}
+		for _, mmc := range mmcs {
+			if mmc.CatalogID == catalogID {
+				return mmc.ID, nil
+			}
+		}
+		return 0, errImportKeyNotFound
+	})
 }
@@ -57,6 +57,13 @@ func TestAccMonolithManifestCatalogResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// ImportState by natural key
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportStateIDFunc(resourceName, "manifest_id", "catalog_id"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
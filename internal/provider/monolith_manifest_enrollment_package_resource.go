@@ -130,8 +130,13 @@ func (r *MonolithManifestEnrollmentPackageResource) Read(ctx context.Context, re
 		return
 	}
 
-	ztlMMEP, _, err := r.client.MonolithManifestEnrollmentPackages.GetByID(ctx, int(data.ID.ValueInt64()))
+	ztlMMEP, ztlResp, err := r.client.MonolithManifestEnrollmentPackages.GetByID(ctx, int(data.ID.ValueInt64()))
 	if err != nil {
+		if isNotFound(ztlResp, err) {
+			tflog.Warn(ctx, "Monolith manifest enrollment package not found, removing it from the state", map[string]interface{}{"id": data.ID.ValueInt64()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Client error",
 			fmt.Sprintf("Unable to read Monolith manifest enrollment package %d, got error: %s", data.ID.ValueInt64(), err),
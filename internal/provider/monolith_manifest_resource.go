@@ -117,8 +117,13 @@ func (r *MonolithManifestResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	ztlMM, _, err := r.client.MonolithManifests.GetByID(ctx, int(data.ID.ValueInt64()))
+	ztlMM, ztlResp, err := r.client.MonolithManifests.GetByID(ctx, int(data.ID.ValueInt64()))
 	if err != nil {
+		if isNotFound(ztlResp, err) {
+			tflog.Warn(ctx, "Monolith manifest not found, removing it from the state", map[string]interface{}{"id": data.ID.ValueInt64()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Client error",
 			fmt.Sprintf("Unable to read Monolith manifest %d, got error: %s", data.ID.ValueInt64(), err),
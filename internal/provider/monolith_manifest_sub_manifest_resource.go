@@ -120,8 +120,13 @@ func (r *MonolithManifestSubManifestResource) Read(ctx context.Context, req reso
 		return
 	}
 
-	ztlMMSM, _, err := r.client.MonolithManifestSubManifests.GetByID(ctx, int(data.ID.ValueInt64()))
+	ztlMMSM, ztlResp, err := r.client.MonolithManifestSubManifests.GetByID(ctx, int(data.ID.ValueInt64()))
 	if err != nil {
+		if isNotFound(ztlResp, err) {
+			tflog.Warn(ctx, "Monolith manifest sub manifest not found, removing it from the state", map[string]interface{}{"id": data.ID.ValueInt64()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Client error",
 			fmt.Sprintf("Unable to read Monolith manifest sub manifest %d, got error: %s", data.ID.ValueInt64(), err),
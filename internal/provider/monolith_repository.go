@@ -1,7 +1,12 @@
 package provider
 
 import (
+	"context"
+
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/zentralopensource/goztl"
 )
@@ -101,6 +106,26 @@ func monolithRepositoryForState(mr *goztl.MonolithRepository) monolithRepository
 	}
 }
 
+// monolithRepositoryWithWriteOnlyForState adds the write-only attributes of
+// the backend secrets to the repository state, using the write-only versions
+// of the prior data.
+func monolithRepositoryWithWriteOnlyForState(ctx context.Context, data monolithRepository, prior monolithRepository) monolithRepository {
+	data.Azure = objectWithWriteOnlyForState(ctx, data.Azure, prior.Azure, "client_secret")
+	data.S3 = objectWithWriteOnlyForState(ctx, data.S3, prior.S3, "secret_access_key", "cloudfront_privkey_pem")
+	return data
+}
+
+// monolithRepositoryWithWriteOnlyConfig sets the backend secrets of the
+// repository plan data to the write-only values of the configuration.
+func monolithRepositoryWithWriteOnlyConfig(ctx context.Context, config tfsdk.Config, data monolithRepository) (monolithRepository, diag.Diagnostics) {
+	var diags, d diag.Diagnostics
+	data.Azure, d = objectWithWriteOnlyConfig(ctx, config, path.Root("azure"), data.Azure, "client_secret")
+	diags.Append(d...)
+	data.S3, d = objectWithWriteOnlyConfig(ctx, config, path.Root("s3"), data.S3, "secret_access_key", "cloudfront_privkey_pem")
+	diags.Append(d...)
+	return data, diags
+}
+
 func monolithRepositoryRequestWithState(data monolithRepository) *goztl.MonolithRepositoryRequest {
 	var mbu *int
 	if !data.MetaBusinessUnitID.IsNull() {
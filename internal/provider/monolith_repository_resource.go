@@ -67,7 +67,7 @@ func (r *MonolithRepositoryResource) Schema(ctx context.Context, req resource.Sc
 			"azure": schema.SingleNestedAttribute{
 				Description:         "Azure Blob Storage backend parameters.",
 				MarkdownDescription: "Azure Blob Storage backend parameters.",
-				Attributes: map[string]schema.Attribute{
+				Attributes: withWriteOnlySecretAttributes(map[string]schema.Attribute{
 					"storage_account": schema.StringAttribute{
 						Description:         "Name of the storage account.",
 						MarkdownDescription: "Name of the storage account.",
@@ -107,13 +107,15 @@ func (r *MonolithRepositoryResource) Schema(ctx context.Context, req resource.Sc
 						Computed:            true,
 						Default:             stringdefault.StaticString(""),
 					},
-				},
+				}, map[string]string{
+					"client_secret": "Client secret of the Azure app registration.",
+				}),
 				Optional: true,
 			},
 			"s3": schema.SingleNestedAttribute{
 				Description:         "S3 backend parameters.",
 				MarkdownDescription: "S3 backend parameters.",
-				Attributes: map[string]schema.Attribute{
+				Attributes: withWriteOnlySecretAttributes(map[string]schema.Attribute{
 					"bucket": schema.StringAttribute{
 						Description:         "Name of the S3 bucket.",
 						MarkdownDescription: "Name of the S3 bucket.",
@@ -191,7 +193,10 @@ func (r *MonolithRepositoryResource) Schema(ctx context.Context, req resource.Sc
 						Computed:            true,
 						Default:             stringdefault.StaticString(""),
 					},
-				},
+				}, map[string]string{
+					"secret_access_key":      "AWS secret access key.",
+					"cloudfront_privkey_pem": "Cloudfront private key in PEM form.",
+				}),
 				Optional: true,
 			},
 		},
@@ -228,7 +233,15 @@ func (r *MonolithRepositoryResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
-	ztlMR, _, err := r.client.MonolithRepositories.Create(ctx, monolithRepositoryRequestWithState(data))
+	// Read the write-only secrets from the configuration
+	reqData, diags := monolithRepositoryWithWriteOnlyConfig(ctx, req.Config, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ztlMR, _, err := r.client.MonolithRepositories.Create(ctx, monolithRepositoryRequestWithState(reqData))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error",
@@ -240,7 +253,7 @@ func (r *MonolithRepositoryResource) Create(ctx context.Context, req resource.Cr
 	tflog.Trace(ctx, "created a Monolith repository")
 
 	// Save data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, monolithRepositoryForState(ztlMR))...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, monolithRepositoryWithWriteOnlyForState(ctx, monolithRepositoryForState(ztlMR), data))...)
 }
 
 func (r *MonolithRepositoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -253,8 +266,13 @@ func (r *MonolithRepositoryResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
-	ztlMR, _, err := r.client.MonolithRepositories.GetByID(ctx, int(data.ID.ValueInt64()))
+	ztlMR, ztlResp, err := r.client.MonolithRepositories.GetByID(ctx, int(data.ID.ValueInt64()))
 	if err != nil {
+		if isNotFound(ztlResp, err) {
+			tflog.Warn(ctx, "Monolith repository not found, removing it from the state", map[string]interface{}{"id": data.ID.ValueInt64()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Client error",
 			fmt.Sprintf("Unable to read Monolith repository %d, got error: %s", data.ID.ValueInt64(), err),
@@ -265,7 +283,7 @@ func (r *MonolithRepositoryResource) Read(ctx context.Context, req resource.Read
 	tflog.Trace(ctx, "read a Monolith repository")
 
 	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, monolithRepositoryForState(ztlMR))...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, monolithRepositoryWithWriteOnlyForState(ctx, monolithRepositoryForState(ztlMR), data))...)
 }
 
 func (r *MonolithRepositoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -278,7 +296,15 @@ func (r *MonolithRepositoryResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
-	ztlMR, _, err := r.client.MonolithRepositories.Update(ctx, int(data.ID.ValueInt64()), monolithRepositoryRequestWithState(data))
+	// Read the write-only secrets from the configuration
+	reqData, diags := monolithRepositoryWithWriteOnlyConfig(ctx, req.Config, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ztlMR, _, err := r.client.MonolithRepositories.Update(ctx, int(data.ID.ValueInt64()), monolithRepositoryRequestWithState(reqData))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error",
@@ -290,7 +316,7 @@ func (r *MonolithRepositoryResource) Update(ctx context.Context, req resource.Up
 	tflog.Trace(ctx, "updated a Monolith repository")
 
 	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, monolithRepositoryForState(ztlMR))...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, monolithRepositoryWithWriteOnlyForState(ctx, monolithRepositoryForState(ztlMR), data))...)
 }
 
 func (r *MonolithRepositoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
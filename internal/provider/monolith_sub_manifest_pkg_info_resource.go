@@ -177,8 +177,13 @@ func (r *MonolithSubManifestPkgInfoResource) Read(ctx context.Context, req resou
 		return
 	}
 
-	ztlMSMPI, _, err := r.client.MonolithSubManifestPkgInfos.GetByID(ctx, int(data.ID.ValueInt64()))
+	ztlMSMPI, ztlResp, err := r.client.MonolithSubManifestPkgInfos.GetByID(ctx, int(data.ID.ValueInt64()))
 	if err != nil {
+		if isNotFound(ztlResp, err) {
+			tflog.Warn(ctx, "Monolith sub manifest pkg info not found, removing it from the state", map[string]interface{}{"id": data.ID.ValueInt64()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Client error",
 			fmt.Sprintf("Unable to read Monolith sub manifest pkg info %d, got error: %s", data.ID.ValueInt64(), err),
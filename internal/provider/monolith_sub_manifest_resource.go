@@ -120,8 +120,13 @@ func (r *MonolithSubManifestResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
-	ztlMSM, _, err := r.client.MonolithSubManifests.GetByID(ctx, int(data.ID.ValueInt64()))
+	ztlMSM, ztlResp, err := r.client.MonolithSubManifests.GetByID(ctx, int(data.ID.ValueInt64()))
 	if err != nil {
+		if isNotFound(ztlResp, err) {
+			tflog.Warn(ctx, "Monolith sub manifest not found, removing it from the state", map[string]interface{}{"id": data.ID.ValueInt64()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Client error",
 			fmt.Sprintf("Unable to read Monolith sub manifest %d, got error: %s", data.ID.ValueInt64(), err),
@@ -193,8 +193,13 @@ func (r *MunkiConfigurationResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
-	ztlMC, _, err := r.client.MunkiConfigurations.GetByID(ctx, int(data.ID.ValueInt64()))
+	ztlMC, ztlResp, err := r.client.MunkiConfigurations.GetByID(ctx, int(data.ID.ValueInt64()))
 	if err != nil {
+		if isNotFound(ztlResp, err) {
+			tflog.Warn(ctx, "Munki configuration not found, removing it from the state", map[string]interface{}{"id": data.ID.ValueInt64()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Client error",
 			fmt.Sprintf("Unable to read Munki configuration %d, got error: %s", data.ID.ValueInt64(), err),
@@ -256,5 +261,14 @@ func (r *MunkiConfigurationResource) Delete(ctx context.Context, req resource.De
 }
 
 func (r *MunkiConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resourceImportStatePassthroughZentralID(ctx, "Munki configuration", req, resp)
+	resourceImportStateZentralIDOrName(ctx, "Munki configuration", req, resp, func(ctx context.Context, name string) (int, error) {
+		obj, _, err := r.client.MunkiConfigurations.GetByName(ctx, name)
+		if err != nil {
+			return 0, err
+		}
+		if obj == nil {
+			return 0, errImportKeyNotFound
+		}
+		return obj.ID, nil
+	})
 }
@@ -154,8 +154,13 @@ func (r *MunkiEnrollmentResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
-	ztlME, _, err := r.client.MunkiEnrollments.GetByID(ctx, int(data.ID.ValueInt64()))
+	ztlME, ztlResp, err := r.client.MunkiEnrollments.GetByID(ctx, int(data.ID.ValueInt64()))
 	if err != nil {
+		if isNotFound(ztlResp, err) {
+			tflog.Warn(ctx, "Munki enrollment not found, removing it from the state", map[string]interface{}{"id": data.ID.ValueInt64()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Client error",
 			fmt.Sprintf("Unable to read Munki enrollment %d, got error: %s", data.ID.ValueInt64(), err),
@@ -186,8 +186,13 @@ func (r *MunkiScriptCheckResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	ztlMSC, _, err := r.client.MunkiScriptChecks.GetByID(ctx, int(data.ID.ValueInt64()))
+	ztlMSC, ztlResp, err := r.client.MunkiScriptChecks.GetByID(ctx, int(data.ID.ValueInt64()))
 	if err != nil {
+		if isNotFound(ztlResp, err) {
+			tflog.Warn(ctx, "Munki script check not found, removing it from the state", map[string]interface{}{"id": data.ID.ValueInt64()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Client error",
 			fmt.Sprintf("Unable to read Munki script check %d, got error: %s", data.ID.ValueInt64(), err),
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// nameFilterAttributes returns the name filter attributes of the plural data sources.
+func nameFilterAttributes(objectsName string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name_prefix": schema.StringAttribute{
+			Description:         fmt.Sprintf("Only return the %s with a name starting with this prefix.", objectsName),
+			MarkdownDescription: fmt.Sprintf("Only return the %s with a name starting with this prefix.", objectsName),
+			Optional:            true,
+		},
+		"name_regex": schema.StringAttribute{
+			Description:         fmt.Sprintf("Only return the %s with a name matching this regular expression.", objectsName),
+			MarkdownDescription: fmt.Sprintf("Only return the %s with a name matching this [regular expression](https://pkg.go.dev/regexp/syntax).", objectsName),
+			Optional:            true,
+		},
+	}
+}
+
+// nameFilter matches the object names, using the name filter attributes of the plural data sources.
+type nameFilter struct {
+	prefix string
+	re     *regexp.Regexp
+}
+
+// newNameFilter returns the name filter built from the name_prefix and name_regex attribute values.
+func newNameFilter(prefix types.String, regex types.String) (nameFilter, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	nf := nameFilter{prefix: prefix.ValueString()}
+	if !regex.IsNull() && !regex.IsUnknown() {
+		re, err := regexp.Compile(regex.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid regular expression",
+				fmt.Sprintf("Unable to compile %q: %s", regex.ValueString(), err),
+			)
+		} else {
+			nf.re = re
+		}
+	}
+	return nf, diags
+}
+
+func (nf nameFilter) match(name string) bool {
+	if !strings.HasPrefix(name, nf.prefix) {
+		return false
+	}
+	return nf.re == nil || nf.re.MatchString(name)
+}
+
+// sortByName sorts the objects returned by the API by name.
+func sortByName[T any](objs []T, name func(T) string) {
+	sort.SliceStable(objs, func(i, j int) bool {
+		return name(objs[i]) < name(objs[j])
+	})
+}
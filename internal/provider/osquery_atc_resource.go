@@ -143,8 +143,13 @@ func (r *OsqueryATCResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	ztlOA, _, err := r.client.OsqueryATC.GetByID(ctx, int(data.ID.ValueInt64()))
+	ztlOA, ztlResp, err := r.client.OsqueryATC.GetByID(ctx, int(data.ID.ValueInt64()))
 	if err != nil {
+		if isNotFound(ztlResp, err) {
+			tflog.Warn(ctx, "Osquery ATC not found, removing it from the state", map[string]interface{}{"id": data.ID.ValueInt64()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Client error",
 			fmt.Sprintf("Unable to read Osquery ATC %d, got error: %s", data.ID.ValueInt64(), err),
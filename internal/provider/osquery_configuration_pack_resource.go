@@ -131,8 +131,13 @@ func (r *OsqueryConfigurationPackResource) Read(ctx context.Context, req resourc
 		return
 	}
 
-	ztlOCP, _, err := r.client.OsqueryConfigurationPacks.GetByID(ctx, int(data.ID.ValueInt64()))
+	ztlOCP, ztlResp, err := r.client.OsqueryConfigurationPacks.GetByID(ctx, int(data.ID.ValueInt64()))
 	if err != nil {
+		if isNotFound(ztlResp, err) {
+			tflog.Warn(ctx, "Osquery configuration pack not found, removing it from the state", map[string]interface{}{"id": data.ID.ValueInt64()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Client error",
 			fmt.Sprintf("Unable to read Osquery configuration pack %d, got error: %s", data.ID.ValueInt64(), err),
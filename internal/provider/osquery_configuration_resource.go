@@ -176,8 +176,13 @@ func (r *OsqueryConfigurationResource) Read(ctx context.Context, req resource.Re
 		return
 	}
 
-	ztlOC, _, err := r.client.OsqueryConfigurations.GetByID(ctx, int(data.ID.ValueInt64()))
+	ztlOC, ztlResp, err := r.client.OsqueryConfigurations.GetByID(ctx, int(data.ID.ValueInt64()))
 	if err != nil {
+		if isNotFound(ztlResp, err) {
+			tflog.Warn(ctx, "Osquery configuration not found, removing it from the state", map[string]interface{}{"id": data.ID.ValueInt64()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Client error",
 			fmt.Sprintf("Unable to read Osquery configuration %d, got error: %s", data.ID.ValueInt64(), err),
@@ -239,5 +244,14 @@ func (r *OsqueryConfigurationResource) Delete(ctx context.Context, req resource.
 }
 
 func (r *OsqueryConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resourceImportStatePassthroughZentralID(ctx, "Osquery configuration", req, resp)
+	resourceImportStateZentralIDOrName(ctx, "Osquery configuration", req, resp, func(ctx context.Context, name string) (int, error) {
+		obj, _, err := r.client.OsqueryConfigurations.GetByName(ctx, name)
+		if err != nil {
+			return 0, err
+		}
+		if obj == nil {
+			return 0, errImportKeyNotFound
+		}
+		return obj.ID, nil
+	})
 }
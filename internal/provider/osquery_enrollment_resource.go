@@ -172,8 +172,13 @@ func (r *OsqueryEnrollmentResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
-	ztlOE, _, err := r.client.OsqueryEnrollments.GetByID(ctx, int(data.ID.ValueInt64()))
+	ztlOE, ztlResp, err := r.client.OsqueryEnrollments.GetByID(ctx, int(data.ID.ValueInt64()))
 	if err != nil {
+		if isNotFound(ztlResp, err) {
+			tflog.Warn(ctx, "Osquery enrollment not found, removing it from the state", map[string]interface{}{"id": data.ID.ValueInt64()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Client error",
 			fmt.Sprintf("Unable to read Osquery enrollment %d, got error: %s", data.ID.ValueInt64(), err),
@@ -145,8 +145,13 @@ func (r *OsqueryFileCategoryResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
-	ztlOFC, _, err := r.client.OsqueryFileCategories.GetByID(ctx, int(data.ID.ValueInt64()))
+	ztlOFC, ztlResp, err := r.client.OsqueryFileCategories.GetByID(ctx, int(data.ID.ValueInt64()))
 	if err != nil {
+		if isNotFound(ztlResp, err) {
+			tflog.Warn(ctx, "Osquery file category not found, removing it from the state", map[string]interface{}{"id": data.ID.ValueInt64()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Client error",
 			fmt.Sprintf("Unable to read Osquery file category %d, got error: %s", data.ID.ValueInt64(), err),
@@ -143,8 +143,13 @@ func (r *OsqueryPackResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	ztlOP, _, err := r.client.OsqueryPacks.GetByID(ctx, int(data.ID.ValueInt64()))
+	ztlOP, ztlResp, err := r.client.OsqueryPacks.GetByID(ctx, int(data.ID.ValueInt64()))
 	if err != nil {
+		if isNotFound(ztlResp, err) {
+			tflog.Warn(ctx, "Osquery pack not found, removing it from the state", map[string]interface{}{"id": data.ID.ValueInt64()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Client error",
 			fmt.Sprintf("Unable to read Osquery pack %d, got error: %s", data.ID.ValueInt64(), err),
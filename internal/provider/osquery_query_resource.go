@@ -209,8 +209,13 @@ func (r *OsqueryQueryResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
-	ztlOQ, _, err := r.client.OsqueryQueries.GetByID(ctx, int(data.ID.ValueInt64()))
+	ztlOQ, ztlResp, err := r.client.OsqueryQueries.GetByID(ctx, int(data.ID.ValueInt64()))
 	if err != nil {
+		if isNotFound(ztlResp, err) {
+			tflog.Warn(ctx, "Osquery query not found, removing it from the state", map[string]interface{}{"id": data.ID.ValueInt64()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Client error",
 			fmt.Sprintf("Unable to read Osquery query %d, got error: %s", data.ID.ValueInt64(), err),
@@ -1,11 +1,14 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"strconv"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/zentralopensource/goztl"
 )
 
 func TestAccOsqueryQueryResource(t *testing.T) {
@@ -140,6 +143,21 @@ func TestAccOsqueryQueryResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// Deleted outside of Terraform
+			{
+				Config: testAccOsqueryQueryResourceConfigTag(thirdName),
+				Check: testAccCheckResourceDisappears(
+					resourceName,
+					func(ctx context.Context, c *goztl.Client, id string) (*goztl.Response, error) {
+						ztlID, err := strconv.Atoi(id)
+						if err != nil {
+							return nil, err
+						}
+						return c.OsqueryQueries.Delete(ctx, ztlID)
+					},
+				),
+				ExpectNonEmptyPlan: true,
+			},
 		},
 	})
 }
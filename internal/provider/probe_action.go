@@ -1,7 +1,12 @@
 package provider
 
 import (
+	"context"
+
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/zentralopensource/goztl"
 )
@@ -76,6 +81,22 @@ func probeActionForState(pa *goztl.ProbeAction) probeAction {
 	}
 }
 
+// probeActionWithWriteOnlyForState adds the write-only attributes of the
+// backend secrets to the action state, using the write-only versions of the
+// prior data.
+func probeActionWithWriteOnlyForState(ctx context.Context, data probeAction, prior probeAction) probeAction {
+	data.HTTPPost = objectWithWriteOnlyForState(ctx, data.HTTPPost, prior.HTTPPost, "password")
+	return data
+}
+
+// probeActionWithWriteOnlyConfig sets the backend secrets of the action plan
+// data to the write-only values of the configuration.
+func probeActionWithWriteOnlyConfig(ctx context.Context, config tfsdk.Config, data probeAction) (probeAction, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	data.HTTPPost, diags = objectWithWriteOnlyConfig(ctx, config, path.Root("http_post"), data.HTTPPost, "password")
+	return data, diags
+}
+
 func probeActionRequestWithState(data probeAction) *goztl.ProbeActionRequest {
 	req := &goztl.ProbeActionRequest{
 		Name:        data.Name.ValueString(),
@@ -72,7 +72,7 @@ func (r *ProbeActionResource) Schema(ctx context.Context, req resource.SchemaReq
 			"http_post": schema.SingleNestedAttribute{
 				Description:         "HTTP Post backend parameters.",
 				MarkdownDescription: "HTTP Post backend parameters.",
-				Attributes: map[string]schema.Attribute{
+				Attributes: withWriteOnlySecretAttributes(map[string]schema.Attribute{
 					"url": schema.StringAttribute{
 						Description:         "URL.",
 						MarkdownDescription: "`URL`.",
@@ -115,8 +115,13 @@ func (r *ProbeActionResource) Schema(ctx context.Context, req resource.SchemaReq
 						Description:         "CEL expression that is used to transform the event data. The input to the expression is a Map with two keys: metadata for the event metadata and payload for the event payload.",
 						MarkdownDescription: "CEL expression that is used to transform the event data. The input to the expression is a `Map` with two keys: `metadata` for the event metadata and `payload` for the event payload.",
 						Optional:            true,
+						Validators: []validator.String{
+							probeActionCELTransformationValidator(),
+						},
 					},
-				},
+				}, map[string]string{
+					"password": "Password for basic authentication.",
+				}),
 				Optional: true,
 			},
 			"slack_incoming_webhook": schema.SingleNestedAttribute{
@@ -166,7 +171,15 @@ func (r *ProbeActionResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
-	ztlPA, _, err := r.client.ProbesActions.Create(ctx, probeActionRequestWithState(data))
+	// Read the write-only secrets from the configuration
+	reqData, diags := probeActionWithWriteOnlyConfig(ctx, req.Config, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ztlPA, _, err := r.client.ProbesActions.Create(ctx, probeActionRequestWithState(reqData))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error",
@@ -178,7 +191,7 @@ func (r *ProbeActionResource) Create(ctx context.Context, req resource.CreateReq
 	tflog.Trace(ctx, "created a probe action")
 
 	// Save data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, probeActionForState(ztlPA))...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, probeActionWithWriteOnlyForState(ctx, probeActionForState(ztlPA), data))...)
 }
 
 func (r *ProbeActionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -191,8 +204,13 @@ func (r *ProbeActionResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	ztlPA, _, err := r.client.ProbesActions.GetByID(ctx, data.ID.ValueString())
+	ztlPA, ztlResp, err := r.client.ProbesActions.GetByID(ctx, data.ID.ValueString())
 	if err != nil {
+		if isNotFound(ztlResp, err) {
+			tflog.Warn(ctx, "Probe action not found, removing it from the state", map[string]interface{}{"id": data.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Client error",
 			fmt.Sprintf("Unable to read probe action %s, got error: %s", data.ID.ValueString(), err),
@@ -203,7 +221,7 @@ func (r *ProbeActionResource) Read(ctx context.Context, req resource.ReadRequest
 	tflog.Trace(ctx, "read a probe action")
 
 	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, probeActionForState(ztlPA))...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, probeActionWithWriteOnlyForState(ctx, probeActionForState(ztlPA), data))...)
 }
 
 func (r *ProbeActionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -216,7 +234,15 @@ func (r *ProbeActionResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	ztlPA, _, err := r.client.ProbesActions.Update(ctx, data.ID.ValueString(), probeActionRequestWithState(data))
+	// Read the write-only secrets from the configuration
+	reqData, diags := probeActionWithWriteOnlyConfig(ctx, req.Config, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ztlPA, _, err := r.client.ProbesActions.Update(ctx, data.ID.ValueString(), probeActionRequestWithState(reqData))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error",
@@ -228,7 +254,7 @@ func (r *ProbeActionResource) Update(ctx context.Context, req resource.UpdateReq
 	tflog.Trace(ctx, "updated a probe action")
 
 	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, probeActionForState(ztlPA))...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, probeActionWithWriteOnlyForState(ctx, probeActionForState(ztlPA), data))...)
 }
 
 func (r *ProbeActionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
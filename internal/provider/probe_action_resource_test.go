@@ -81,6 +81,22 @@ func TestAccProbeActionResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// Update and Read, write-only password
+			{
+				Config: testAccProbeActionResourceConfigHTTPPostWriteOnly(secondName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						resourceName, "name", secondName),
+					resource.TestCheckResourceAttr(
+						resourceName, "http_post.username", "yolo"),
+					resource.TestCheckNoResourceAttr(
+						resourceName, "http_post.password"),
+					resource.TestCheckNoResourceAttr(
+						resourceName, "http_post.password_wo"),
+					resource.TestCheckResourceAttr(
+						resourceName, "http_post.password_wo_version", "1"),
+				),
+			},
 			// Update and Read
 			{
 				Config: testAccProbeActionResourceConfigSlack(secondName),
@@ -139,6 +155,22 @@ resource "zentral_probe_action" "test" {
 `, name)
 }
 
+func testAccProbeActionResourceConfigHTTPPostWriteOnly(name string) string {
+	return fmt.Sprintf(`
+resource "zentral_probe_action" "test" {
+  name        = %[1]q
+  description = "First description"
+  backend     = "HTTP_POST"
+  http_post = {
+    url                 = "https://www.example.com/post"
+    username            = "yolo"
+    password_wo         = "fomo"
+    password_wo_version = 1
+  }
+}
+`, name)
+}
+
 func testAccProbeActionResourceConfigSlack(name string) string {
 	return fmt.Sprintf(`
 resource "zentral_probe_action" "test" {
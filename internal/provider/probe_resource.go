@@ -247,8 +247,13 @@ func (r *ProbeResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	ztlP, _, err := r.client.Probes.GetByID(ctx, int(data.ID.ValueInt64()))
+	ztlP, ztlResp, err := r.client.Probes.GetByID(ctx, int(data.ID.ValueInt64()))
 	if err != nil {
+		if isNotFound(ztlResp, err) {
+			tflog.Warn(ctx, "Probe not found, removing it from the state", map[string]interface{}{"id": data.ID.ValueInt64()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Client error",
 			fmt.Sprintf("Unable to read probe action %d, got error: %s", data.ID.ValueInt64(), err),
@@ -1,11 +1,14 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"strconv"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/zentralopensource/goztl"
 )
 
 func TestAccProbeResource(t *testing.T) {
@@ -172,6 +175,21 @@ func TestAccProbeResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// Deleted outside of Terraform
+			{
+				Config: testAccProbeResourceFullUpdated(secondName),
+				Check: testAccCheckResourceDisappears(
+					resourceName,
+					func(ctx context.Context, c *goztl.Client, id string) (*goztl.Response, error) {
+						ztlID, err := strconv.Atoi(id)
+						if err != nil {
+							return nil, err
+						}
+						return c.Probes.Delete(ctx, ztlID)
+					},
+				),
+				ExpectNonEmptyPlan: true,
+			},
 		},
 	})
 }
@@ -3,18 +3,30 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"os"
+	"strconv"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/zentralopensource/goztl"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
 var _ provider.Provider = &ZentralProvider{}
+var _ provider.ProviderWithEphemeralResources = &ZentralProvider{}
+var _ provider.ProviderWithFunctions = &ZentralProvider{}
 
 // ZentralProvider defines the provider implementation.
 type ZentralProvider struct {
@@ -26,8 +38,22 @@ type ZentralProvider struct {
 
 // ZentralProviderModel describes the provider data model.
 type ZentralProviderModel struct {
-	BaseURL types.String `tfsdk:"base_url"`
-	Token   types.String `tfsdk:"token"`
+	BaseURL            types.String  `tfsdk:"base_url"`
+	Token              types.String  `tfsdk:"token"`
+	TokenCommand       types.String  `tfsdk:"token_command"`
+	Profile            types.String  `tfsdk:"profile"`
+	CredentialsFile    types.String  `tfsdk:"credentials_file"`
+	MaxRetries         types.Int64   `tfsdk:"max_retries"`
+	RetryWaitMin       types.String  `tfsdk:"retry_wait_min"`
+	RetryWaitMax       types.String  `tfsdk:"retry_wait_max"`
+	RequestsPerSecond  types.Float64 `tfsdk:"requests_per_second"`
+	RequestTimeout     types.String  `tfsdk:"request_timeout"`
+	CACertPEM          types.String  `tfsdk:"ca_cert_pem"`
+	CACertFile         types.String  `tfsdk:"ca_cert_file"`
+	ClientCertPEM      types.String  `tfsdk:"client_cert_pem"`
+	ClientKeyPEM       types.String  `tfsdk:"client_key_pem"`
+	InsecureSkipVerify types.Bool    `tfsdk:"insecure_skip_verify"`
+	ProxyURL           types.String  `tfsdk:"proxy_url"`
 }
 
 func (p *ZentralProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -42,13 +68,132 @@ func (p *ZentralProvider) Schema(ctx context.Context, req provider.SchemaRequest
 				Optional:    true,
 				Description: "The API base URL.",
 				MarkdownDescription: "The base URL where the Zentral API is mounted, including the path. " +
-					"Can also be set using the `ZTL_API_BASE_URL` environment variable.",
+					"Can also be set using the `ZTL_API_BASE_URL` environment variable, or in a credentials file profile.",
 			},
 			"token": schema.StringAttribute{
 				Optional:  true,
 				Sensitive: true,
 				Description: "The Zentral service account or user token. " +
-					"Can also be set using the `ZTL_API_TOKEN` environment variable.",
+					"Can also be set using the `ZTL_API_TOKEN` environment variable, or in a credentials file profile.",
+			},
+			"token_command": schema.StringAttribute{
+				Optional: true,
+				Description: "Command run with the shell to get the Zentral token on its standard output, " +
+					"for example to fetch it from a secrets manager. Ignored if token is set. " +
+					"Can also be set using the ZTL_API_TOKEN_COMMAND environment variable, or in a credentials file profile.",
+				MarkdownDescription: "Command run with the shell to get the Zentral token on its standard output, " +
+					"for example to fetch it from a secrets manager. Ignored if `token` is set. " +
+					"Can also be set using the `ZTL_API_TOKEN_COMMAND` environment variable, or in a credentials file profile.",
+			},
+			"profile": schema.StringAttribute{
+				Optional: true,
+				Description: "Name of the credentials file profile providing the base_url, token or token_command, " +
+					"when they are not set in the provider block or in the environment. " +
+					"When a profile is selected, the credentials environment variables are ignored, " +
+					"and the base_url and token must come from the same source. " +
+					"Defaults to the default profile, if it exists. " +
+					"Can also be set using the ZTL_API_PROFILE environment variable.",
+				MarkdownDescription: "Name of the credentials file profile providing the `base_url`, `token` or `token_command`, " +
+					"when they are not set in the provider block or in the environment. " +
+					"When a profile is selected, the credentials environment variables are ignored, " +
+					"and the `base_url` and `token` must come from the same source. " +
+					"Defaults to the `default` profile, if it exists. " +
+					"Can also be set using the `ZTL_API_PROFILE` environment variable.",
+			},
+			"credentials_file": schema.StringAttribute{
+				Optional: true,
+				Description: "Path to the credentials file. Defaults to ~/.config/zentral/credentials. " +
+					"Can also be set using the ZTL_API_CREDENTIALS_FILE environment variable.",
+				MarkdownDescription: "Path to the credentials file. Defaults to `~/.config/zentral/credentials`. " +
+					"Can also be set using the `ZTL_API_CREDENTIALS_FILE` environment variable.",
+			},
+			"max_retries": schema.Int64Attribute{
+				Optional: true,
+				Description: "Maximum number of retries of the API requests failing with a connection error, " +
+					"a 429 or a 5xx response. Defaults to 4. Set to 0 to disable the retries.",
+				MarkdownDescription: "Maximum number of retries of the API requests failing with a connection error, " +
+					"a `429` or a `5xx` response. Defaults to `4`. Set to `0` to disable the retries.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_wait_min": schema.StringAttribute{
+				Optional: true,
+				Description: "Minimum time to wait before retrying a request, as a duration string (e.g. 500ms). " +
+					"The wait time increases exponentially between retries. Defaults to 1s.",
+				MarkdownDescription: "Minimum time to wait before retrying a request, as a duration string (e.g. `500ms`). " +
+					"The wait time increases exponentially between retries. Defaults to `1s`.",
+			},
+			"retry_wait_max": schema.StringAttribute{
+				Optional: true,
+				Description: "Maximum time to wait before retrying a request, as a duration string (e.g. 1m). " +
+					"A longer Retry-After response header value takes precedence. Defaults to 30s.",
+				MarkdownDescription: "Maximum time to wait before retrying a request, as a duration string (e.g. `1m`). " +
+					"A longer `Retry-After` response header value takes precedence. Defaults to `30s`.",
+			},
+			"requests_per_second": schema.Float64Attribute{
+				Optional:            true,
+				Description:         "Maximum number of API requests sent per second. Unlimited by default.",
+				MarkdownDescription: "Maximum number of API requests sent per second. Unlimited by default.",
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"request_timeout": schema.StringAttribute{
+				Optional: true,
+				Description: "Timeout of each API request attempt, as a duration string (e.g. 30s). " +
+					"No timeout by default.",
+				MarkdownDescription: "Timeout of each API request attempt, as a duration string (e.g. `30s`). " +
+					"No timeout by default.",
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Optional: true,
+				Description: "PEM encoded CA certificates used to verify the Zentral server certificate, " +
+					"in addition to the system CAs. Conflicts with ca_cert_file. " +
+					"Can also be set using the ZTL_API_CA_CERT_PEM environment variable.",
+				MarkdownDescription: "PEM encoded CA certificates used to verify the Zentral server certificate, " +
+					"in addition to the system CAs. Conflicts with `ca_cert_file`. " +
+					"Can also be set using the `ZTL_API_CA_CERT_PEM` environment variable.",
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Optional: true,
+				Description: "Path to a file containing PEM encoded CA certificates used to verify the Zentral server certificate, " +
+					"in addition to the system CAs. Conflicts with ca_cert_pem. " +
+					"Can also be set using the ZTL_API_CA_CERT_FILE environment variable.",
+				MarkdownDescription: "Path to a file containing PEM encoded CA certificates used to verify the Zentral server certificate, " +
+					"in addition to the system CAs. Conflicts with `ca_cert_pem`. " +
+					"Can also be set using the `ZTL_API_CA_CERT_FILE` environment variable.",
+			},
+			"client_cert_pem": schema.StringAttribute{
+				Optional: true,
+				Description: "PEM encoded client certificate used for mutual TLS authentication. Requires client_key_pem. " +
+					"Can also be set using the ZTL_API_CLIENT_CERT_PEM environment variable.",
+				MarkdownDescription: "PEM encoded client certificate used for mutual TLS authentication. Requires `client_key_pem`. " +
+					"Can also be set using the `ZTL_API_CLIENT_CERT_PEM` environment variable.",
+			},
+			"client_key_pem": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Description: "PEM encoded private key of the client certificate. Requires client_cert_pem. " +
+					"Can also be set using the ZTL_API_CLIENT_KEY_PEM environment variable.",
+				MarkdownDescription: "PEM encoded private key of the client certificate. Requires `client_cert_pem`. " +
+					"Can also be set using the `ZTL_API_CLIENT_KEY_PEM` environment variable.",
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Optional: true,
+				Description: "Disable the verification of the Zentral server certificate. Only for testing! " +
+					"Can also be set using the ZTL_API_INSECURE_SKIP_VERIFY environment variable.",
+				MarkdownDescription: "Disable the verification of the Zentral server certificate. **Only for testing!** " +
+					"Can also be set using the `ZTL_API_INSECURE_SKIP_VERIFY` environment variable.",
+			},
+			"proxy_url": schema.StringAttribute{
+				Optional: true,
+				Description: "URL of the HTTP proxy used to connect to the Zentral server. " +
+					"Defaults to the proxy configured with the standard HTTPS_PROXY and NO_PROXY environment variables. " +
+					"Can also be set using the ZTL_API_PROXY_URL environment variable.",
+				MarkdownDescription: "URL of the HTTP proxy used to connect to the Zentral server. " +
+					"Defaults to the proxy configured with the standard `HTTPS_PROXY` and `NO_PROXY` environment variables. " +
+					"Can also be set using the `ZTL_API_PROXY_URL` environment variable.",
 			},
 		},
 	}
@@ -63,8 +208,7 @@ func (p *ZentralProvider) Configure(ctx context.Context, req provider.ConfigureR
 		return
 	}
 
-	// base URL
-	var baseURL string
+	// base URL & API token
 	if data.BaseURL.IsUnknown() {
 		resp.Diagnostics.AddWarning(
 			"Zentral provider configuration error",
@@ -73,10 +217,39 @@ func (p *ZentralProvider) Configure(ctx context.Context, req provider.ConfigureR
 		return
 	}
 
-	if data.BaseURL.IsNull() {
-		baseURL = os.Getenv("ZTL_API_BASE_URL")
-	} else {
-		baseURL = data.BaseURL.ValueString()
+	if data.Token.IsUnknown() || data.TokenCommand.IsUnknown() {
+		resp.Diagnostics.AddWarning(
+			"Zentral provider configuration error",
+			"Cannot use unknown value as token",
+		)
+		return
+	}
+
+	if data.Profile.IsUnknown() || data.CredentialsFile.IsUnknown() {
+		resp.Diagnostics.AddWarning(
+			"Zentral provider configuration error",
+			"Cannot use unknown value as credentials profile",
+		)
+		return
+	}
+
+	baseURL, token, err := resolveCredentials(
+		ctx,
+		providerCredentials{
+			BaseURL:         data.BaseURL.ValueString(),
+			Token:           data.Token.ValueString(),
+			TokenCommand:    data.TokenCommand.ValueString(),
+			Profile:         data.Profile.ValueString(),
+			CredentialsFile: data.CredentialsFile.ValueString(),
+		},
+		os.Getenv,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Zentral provider configuration error",
+			"Unable to get the credentials:\n\n"+err.Error(),
+		)
+		return
 	}
 
 	if baseURL == "" {
@@ -87,32 +260,120 @@ func (p *ZentralProvider) Configure(ctx context.Context, req provider.ConfigureR
 		return
 	}
 
-	// API token
-	var token string
-	if data.Token.IsUnknown() {
+	if token == "" {
+		resp.Diagnostics.AddError(
+			"Zentral provider configuration error",
+			"Token cannot be an empty string",
+		)
+		return
+	}
+
+	// HTTP client
+	httpCfg := defaultHTTPClientConfig()
+
+	if data.MaxRetries.IsUnknown() || data.RetryWaitMin.IsUnknown() || data.RetryWaitMax.IsUnknown() ||
+		data.RequestsPerSecond.IsUnknown() || data.RequestTimeout.IsUnknown() {
 		resp.Diagnostics.AddWarning(
 			"Zentral provider configuration error",
-			"Cannot use unknown value as token",
+			"Cannot use unknown values to configure the HTTP client",
+		)
+		return
+	}
+
+	if !data.MaxRetries.IsNull() {
+		httpCfg.MaxRetries = int(data.MaxRetries.ValueInt64())
+	}
+	resp.Diagnostics.Append(durationWithConfig(data.RetryWaitMin, path.Root("retry_wait_min"), &httpCfg.RetryWaitMin)...)
+	resp.Diagnostics.Append(durationWithConfig(data.RetryWaitMax, path.Root("retry_wait_max"), &httpCfg.RetryWaitMax)...)
+	resp.Diagnostics.Append(durationWithConfig(data.RequestTimeout, path.Root("request_timeout"), &httpCfg.RequestTimeout)...)
+	if !data.RequestsPerSecond.IsNull() {
+		httpCfg.RequestsPerSecond = data.RequestsPerSecond.ValueFloat64()
+	}
+
+	// TLS & proxy
+	if data.CACertPEM.IsUnknown() || data.CACertFile.IsUnknown() || data.ClientCertPEM.IsUnknown() ||
+		data.ClientKeyPEM.IsUnknown() || data.InsecureSkipVerify.IsUnknown() || data.ProxyURL.IsUnknown() {
+		resp.Diagnostics.AddWarning(
+			"Zentral provider configuration error",
+			"Cannot use unknown values to configure the TLS settings or the proxy",
 		)
 		return
 	}
 
-	if data.Token.IsNull() {
-		token = os.Getenv("ZTL_API_TOKEN")
+	caCertPEM := stringWithConfigOrEnv(data.CACertPEM, "ZTL_API_CA_CERT_PEM")
+	caCertFile := stringWithConfigOrEnv(data.CACertFile, "ZTL_API_CA_CERT_FILE")
+	if caCertPEM != "" && caCertFile != "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ca_cert_file"),
+			"Zentral provider configuration error",
+			"ca_cert_pem and ca_cert_file cannot be both set",
+		)
+	} else if caCertFile != "" {
+		b, err := os.ReadFile(caCertFile)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ca_cert_file"),
+				"Zentral provider configuration error",
+				fmt.Sprintf("Unable to read the CA certificates file: %s", err),
+			)
+		}
+		httpCfg.CACertPEM = string(b)
 	} else {
-		token = data.Token.ValueString()
+		httpCfg.CACertPEM = caCertPEM
 	}
 
-	if token == "" {
+	httpCfg.ClientCertPEM = stringWithConfigOrEnv(data.ClientCertPEM, "ZTL_API_CLIENT_CERT_PEM")
+	httpCfg.ClientKeyPEM = stringWithConfigOrEnv(data.ClientKeyPEM, "ZTL_API_CLIENT_KEY_PEM")
+
+	if !data.InsecureSkipVerify.IsNull() {
+		httpCfg.InsecureSkipVerify = data.InsecureSkipVerify.ValueBool()
+	} else if v := os.Getenv("ZTL_API_INSECURE_SKIP_VERIFY"); v != "" {
+		insecureSkipVerify, err := strconv.ParseBool(v)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Zentral provider configuration error",
+				fmt.Sprintf("Invalid ZTL_API_INSECURE_SKIP_VERIFY environment variable value: %s", err),
+			)
+		}
+		httpCfg.InsecureSkipVerify = insecureSkipVerify
+	}
+
+	if proxyURL := stringWithConfigOrEnv(data.ProxyURL, "ZTL_API_PROXY_URL"); proxyURL != "" {
+		u, err := url.Parse(proxyURL)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("proxy_url"),
+				"Zentral provider configuration error",
+				fmt.Sprintf("Invalid proxy URL: %q", proxyURL),
+			)
+		}
+		httpCfg.ProxyURL = u
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if httpCfg.RetryWaitMin > httpCfg.RetryWaitMax {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_wait_min"),
+			"Zentral provider configuration error",
+			"retry_wait_min cannot be greater than retry_wait_max",
+		)
+		return
+	}
+
+	httpClient, err := newHTTPClient(ctx, httpCfg)
+	if err != nil {
 		resp.Diagnostics.AddError(
 			"Zentral provider configuration error",
-			"Token cannot be an empty string",
+			"Unable to configure the HTTP client:\n\n"+err.Error(),
 		)
 		return
 	}
 
 	userAgent := fmt.Sprintf("terraform-provider-zentral/%s", p.version)
-	c, err := goztl.NewClient(nil, baseURL, token, goztl.SetUserAgent(userAgent))
+	c, err := goztl.NewClient(httpClient, baseURL, token, goztl.SetUserAgent(userAgent))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to create client",
@@ -121,9 +382,45 @@ func (p *ZentralProvider) Configure(ctx context.Context, req provider.ConfigureR
 	}
 
 	resp.DataSourceData = c
+	resp.EphemeralResourceData = c
 	resp.ResourceData = c
 }
 
+// stringWithConfigOrEnv returns the attribute value if set,
+// or the value of the environment variable.
+func stringWithConfigOrEnv(s types.String, env string) string {
+	if s.IsNull() {
+		return os.Getenv(env)
+	}
+	return s.ValueString()
+}
+
+// durationWithConfig parses an optional duration string attribute.
+// The duration is left untouched if the attribute is null.
+func durationWithConfig(s types.String, p path.Path, d *time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if s.IsNull() {
+		return diags
+	}
+	v, err := time.ParseDuration(s.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			p,
+			"Zentral provider configuration error",
+			fmt.Sprintf("Invalid duration: %s", err),
+		)
+	} else if v < 0 {
+		diags.AddAttributeError(
+			p,
+			"Zentral provider configuration error",
+			"Duration cannot be negative",
+		)
+	} else {
+		*d = v
+	}
+	return diags
+}
+
 func (p *ZentralProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewGWSGroupTagMappingResource,
@@ -145,6 +442,7 @@ func (p *ZentralProvider) Resources(ctx context.Context) []func() resource.Resou
 		NewMDMPackageResource,
 		NewMDMProfileResource,
 		NewMDMProvisioningProfileResource,
+		NewMDMPushCertificateResource,
 		NewMDMRecoveryPasswordConfigResource,
 		NewMDMSCEPIssuerResource,
 		NewMDMSoftwareUpdateEnforcementResource,
@@ -174,6 +472,7 @@ func (p *ZentralProvider) Resources(ctx context.Context) []func() resource.Resou
 		NewSantaConfigurationResource,
 		NewSantaEnrollmentResource,
 		NewSantaRuleResource,
+		NewSantaRulesBulkResource,
 		NewStoreResource,
 		NewTagResource,
 		NewTaxonomyResource,
@@ -185,13 +484,16 @@ func (p *ZentralProvider) DataSources(ctx context.Context) []func() datasource.D
 		NewGWSConnectionDataSource,
 		NewJMESPathCheckDataSource,
 		NewMetaBusinessUnitDataSource,
+		NewMetaBusinessUnitsDataSource,
 		NewMDMACMEIssuerDataSource,
 		NewMDMArtifactDataSource,
 		NewMDMBlueprintDataSource,
+		NewMDMBlueprintResolutionDataSource,
 		NewMDMFileVaultConfigDataSource,
 		NewMDMDEPVirtualServerDataSource,
 		NewMDMLocationDataSource,
 		NewMDMLocationAssetDataSource,
+		NewMDMLocationAssetsDataSource,
 		NewMDMOTAEnrollmentDataSource,
 		NewMDMPushCertificateDataSource,
 		NewMDMRecoveryPasswordConfigDataSource,
@@ -217,11 +519,31 @@ func (p *ZentralProvider) DataSources(ctx context.Context) []func() datasource.D
 		NewSantaConfigurationDataSource,
 		NewSantaEnrollmentDataSource,
 		NewSantaRuleDataSource,
+		NewSantaRulesDataSource,
 		NewTagDataSource,
+		NewTagsDataSource,
+		NewTaxonomiesDataSource,
 		NewTaxonomyDataSource,
 	}
 }
 
+func (p *ZentralProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewMDMOTAEnrollmentEphemeralResource,
+		NewMonolithEnrollmentEphemeralResource,
+		NewMunkiEnrollmentEphemeralResource,
+		NewOsqueryEnrollmentEphemeralResource,
+		NewSantaEnrollmentEphemeralResource,
+	}
+}
+
+func (p *ZentralProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewSantaRulesFromFunction,
+		NewShardFunction,
+	}
+}
+
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
 		return &ZentralProvider{
@@ -1,10 +1,18 @@
 package provider
 
 import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/zentralopensource/goztl"
+	"github.com/zentralopensource/terraform-provider-zentral/internal/zentraltest"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -15,8 +23,95 @@ var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServe
 	"zentral": providerserver.NewProtocol6WithError(New("test")()),
 }
 
+// testAccOfflineTests are the acceptance tests checked to pass against the
+// in-memory Zentral API stand-in. The stand-in only implements the generic
+// behavior of the API endpoints, and only sets the server computed fields of a
+// few collections, so a test must be checked against it before being added
+// here.
+var testAccOfflineTests = map[string]bool{
+	"TestAccJMESPathCheckResource":       true,
+	"TestAccMetaBusinessUnitDataSource":  true,
+	"TestAccMetaBusinessUnitResource":    true,
+	"TestAccMonolithConditionResource":   true,
+	"TestAccMunkiConfigurationResource":  true,
+	"TestAccOsqueryATCDataSource":        true,
+	"TestAccOsqueryATCResource":          true,
+	"TestAccOsqueryFileCategoryResource": true,
+	"TestAccOsqueryPackDataSource":       true,
+	"TestAccOsqueryPackResource":         true,
+	"TestAccSantaConfigurationResource":  true,
+	"TestAccTagResource":                 true,
+	"TestAccTaxonomyResource":            true,
+}
+
+// testAccOffline returns true if the acceptance tests run against the
+// in-memory Zentral API stand-in.
+func testAccOffline() bool {
+	return os.Getenv("TF_ACC") != "" && os.Getenv("ZTL_TEST_OFFLINE") != ""
+}
+
+// TestMain starts the in-memory Zentral API stand-in when the offline
+// acceptance tests are requested with the ZTL_TEST_OFFLINE environment
+// variable.
+func TestMain(m *testing.M) {
+	if !testAccOffline() {
+		os.Exit(m.Run())
+	}
+
+	s := zentraltest.NewServer()
+	os.Setenv("ZTL_API_BASE_URL", s.BaseURL())
+	os.Setenv("ZTL_API_TOKEN", zentraltest.Token)
+	code := m.Run()
+	s.Close()
+	os.Exit(code)
+}
+
 func testAccPreCheck(t *testing.T) {
 	// You can add code here to run prior to any test case execution, for example assertions
 	// about the appropriate environment variables being set are common to see in a pre-check
 	// function.
+	if testAccOffline() && !testAccOfflineTests[t.Name()] {
+		t.Skip("not checked against the Zentral API stand-in")
+	}
+}
+
+// testAccClient returns a Zentral API client configured using the same
+// environment variables as the provider, to manipulate the Zentral objects
+// outside of Terraform during acceptance testing.
+func testAccClient() (*goztl.Client, error) {
+	return goztl.NewClient(nil, os.Getenv("ZTL_API_BASE_URL"), os.Getenv("ZTL_API_TOKEN"))
+}
+
+// testAccCheckResourceDisappears deletes the Zentral object of a resource
+// directly with the API client, like a deletion in the Zentral GUI would.
+func testAccCheckResourceDisappears(resourceName string, delete func(context.Context, *goztl.Client, string) (*goztl.Response, error)) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %s not found in state", resourceName)
+		}
+		c, err := testAccClient()
+		if err != nil {
+			return err
+		}
+		_, err = delete(context.Background(), c, rs.Primary.ID)
+		return err
+	}
+}
+
+// testAccImportStateIDFunc returns the import ID of a resource built from
+// its state attribute values, separated by slashes, to test the imports
+// using the natural keys.
+func testAccImportStateIDFunc(resourceName string, attrNames ...string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource %s not found in state", resourceName)
+		}
+		parts := make([]string, 0, len(attrNames))
+		for _, attrName := range attrNames {
+			parts = append(parts, rs.Primary.Attributes[attrName])
+		}
+		return strings.Join(parts, "/"), nil
+	}
 }
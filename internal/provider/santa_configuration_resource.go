@@ -210,8 +210,13 @@ func (r *SantaConfigurationResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
-	ztlSC, _, err := r.client.SantaConfigurations.GetByID(ctx, int(data.ID.ValueInt64()))
+	ztlSC, ztlResp, err := r.client.SantaConfigurations.GetByID(ctx, int(data.ID.ValueInt64()))
 	if err != nil {
+		if isNotFound(ztlResp, err) {
+			tflog.Warn(ctx, "Santa configuration not found, removing it from the state", map[string]interface{}{"id": data.ID.ValueInt64()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Client error",
 			fmt.Sprintf("Unable to read Santa configuration %d, got error: %s", data.ID.ValueInt64(), err),
@@ -273,5 +278,14 @@ func (r *SantaConfigurationResource) Delete(ctx context.Context, req resource.De
 }
 
 func (r *SantaConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resourceImportStatePassthroughZentralID(ctx, "Santa configuration", req, resp)
+	resourceImportStateZentralIDOrName(ctx, "Santa configuration", req, resp, func(ctx context.Context, name string) (int, error) {
+		obj, _, err := r.client.SantaConfigurations.GetByName(ctx, name)
+		if err != nil {
+			return 0, err
+		}
+		if obj == nil {
+			return 0, errImportKeyNotFound
+		}
+		return obj.ID, nil
+	})
 }
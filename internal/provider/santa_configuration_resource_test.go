@@ -101,6 +101,13 @@ func TestAccSantaConfigurationResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// ImportState by name
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     "name:" + secondName,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
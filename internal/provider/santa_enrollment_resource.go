@@ -159,8 +159,13 @@ func (r *SantaEnrollmentResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
-	ztlSE, _, err := r.client.SantaEnrollments.GetByID(ctx, int(data.ID.ValueInt64()))
+	ztlSE, ztlResp, err := r.client.SantaEnrollments.GetByID(ctx, int(data.ID.ValueInt64()))
 	if err != nil {
+		if isNotFound(ztlResp, err) {
+			tflog.Warn(ctx, "Santa enrollment not found, removing it from the state", map[string]interface{}{"id": data.ID.ValueInt64()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Client error",
 			fmt.Sprintf("Unable to read Santa enrollment %d, got error: %s", data.ID.ValueInt64(), err),
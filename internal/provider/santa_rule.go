@@ -1,6 +1,12 @@
 package provider
 
 import (
+	"context"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/zentralopensource/goztl"
@@ -39,6 +45,93 @@ type santaRule struct {
 	Version               types.Int64  `tfsdk:"version"`
 }
 
+var santaRuleAttrTypes = map[string]attr.Type{
+	"id":                      types.Int64Type,
+	"configuration_id":        types.Int64Type,
+	"policy":                  types.StringType,
+	"cel_expr":                types.StringType,
+	"target_type":             types.StringType,
+	"target_identifier":       types.StringType,
+	"description":             types.StringType,
+	"custom_message":          types.StringType,
+	"custom_url":              types.StringType,
+	"ruleset_id":              types.Int64Type,
+	"primary_users":           types.SetType{ElemType: types.StringType},
+	"excluded_primary_users":  types.SetType{ElemType: types.StringType},
+	"serial_numbers":          types.SetType{ElemType: types.StringType},
+	"excluded_serial_numbers": types.SetType{ElemType: types.StringType},
+	"tag_ids":                 types.SetType{ElemType: types.Int64Type},
+	"excluded_tag_ids":        types.SetType{ElemType: types.Int64Type},
+	"version":                 types.Int64Type,
+}
+
+// santaRulesPath is the Zentral API endpoint of the Santa rules.
+const santaRulesPath = "santa/rules/"
+
+// listSantaRules lists the Santa rules filtered by the Zentral API, with the
+// query parameters. The goztl client can only list all the rules.
+func listSantaRules(ctx context.Context, c *goztl.Client, query url.Values) ([]goztl.SantaRule, *goztl.Response, error) {
+	req, err := c.NewRequest(ctx, http.MethodGet, santaRulesPath+"?"+query.Encode(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var srs []goztl.SantaRule
+	resp, err := c.Do(ctx, req, &srs)
+	if err != nil {
+		return nil, resp, err
+	}
+	return srs, resp, nil
+}
+
+// santaRuleFilters are the filters of the zentral_santa_rules data source. The
+// null filters match all the rules.
+type santaRuleFilters struct {
+	ConfigurationID  types.Int64
+	Policy           types.String
+	TargetType       types.String
+	TargetIdentifier types.String
+	TagID            types.Int64
+	RulesetManaged   types.Bool
+}
+
+// query returns the filters that can be applied by the Zentral API.
+func (f santaRuleFilters) query() url.Values {
+	query := url.Values{}
+	if !f.ConfigurationID.IsNull() {
+		query.Set("configuration_id", strconv.FormatInt(f.ConfigurationID.ValueInt64(), 10))
+	}
+	if !f.TargetType.IsNull() {
+		query.Set("target_type", f.TargetType.ValueString())
+	}
+	if !f.TargetIdentifier.IsNull() {
+		query.Set("target_identifier", f.TargetIdentifier.ValueString())
+	}
+	return query
+}
+
+func (f santaRuleFilters) match(sr santaRule) bool {
+	if !f.ConfigurationID.IsNull() && !f.ConfigurationID.Equal(sr.ConfigurationID) {
+		return false
+	}
+	if !f.Policy.IsNull() && !f.Policy.Equal(sr.Policy) {
+		return false
+	}
+	if !f.TargetType.IsNull() && !f.TargetType.Equal(sr.TargetType) {
+		return false
+	}
+	if !f.TargetIdentifier.IsNull() && !f.TargetIdentifier.Equal(sr.TargetIdentifier) {
+		return false
+	}
+	if !f.TagID.IsNull() && !slices.ContainsFunc(sr.TagIDs.Elements(), f.TagID.Equal) {
+		return false
+	}
+	// a rule is managed by a Zentral Santa ruleset if it has a ruleset ID
+	if !f.RulesetManaged.IsNull() && f.RulesetManaged.ValueBool() == sr.RulesetID.IsNull() {
+		return false
+	}
+	return true
+}
+
 func santaRuleForState(sr *goztl.SantaRule) santaRule {
 	var policy string
 	switch sr.Policy {
@@ -3,6 +3,8 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -35,133 +37,147 @@ func (r *SantaRuleResource) Metadata(ctx context.Context, req resource.MetadataR
 	resp.TypeName = req.ProviderTypeName + "_santa_rule"
 }
 
-func (r *SantaRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		Description:         "Manages Santa rules.",
-		MarkdownDescription: "The resource `zentral_santa_rule` manages Santa rules.",
-
-		Attributes: map[string]schema.Attribute{
-			"id": schema.Int64Attribute{
-				Description:         "ID of the Santa rule.",
-				MarkdownDescription: "`ID` of the Santa rule.",
-				Computed:            true,
-				PlanModifiers: []planmodifier.Int64{
-					int64planmodifier.UseStateForUnknown(),
-				},
-			},
-			"configuration_id": schema.Int64Attribute{
-				Description:         "ID of the Santa configuration.",
-				MarkdownDescription: "`ID` of the Santa configuration.",
-				Required:            true,
-			},
-			"policy": schema.StringAttribute{
-				Description:         "Policy. Valid values are ALLOWLIST, ALLOWLIST_COMPILER, BLOCKLIST, CEL, and SILENT_BLOCKLIST.",
-				MarkdownDescription: "Policy. Valid values are `ALLOWLIST`, `ALLOWLIST_COMPILER`, `BLOCKLIST`, `CEL`, and `SILENT_BLOCKLIST`.",
-				Required:            true,
-				Validators: []validator.String{
-					stringvalidator.OneOf([]string{tfSantaAllowlist, tfSantaAllowlistCompiler, tfSantaCEL, tfSantaBlocklist, tfSantaSilentBlocklist}...),
-				},
-			},
-			"cel_expr": schema.StringAttribute{
-				Description:         "CEL expression. Only valid for `CEL` policy rule.",
-				MarkdownDescription: "CEL expression. Only valid for `CEL` policy rule.",
-				Optional:            true,
-				Computed:            true,
-				Default:             stringdefault.StaticString(""),
-			},
-			"target_type": schema.StringAttribute{
-				Description:         "Target type. Valid values are BINARY, CDHASH, CERTIFICATE, SIGNINGID and TEAMID.",
-				MarkdownDescription: "Target type. Valid values are `BINARY`, `CDHASH`, `CERTIFICATE`, `SIGNINGID` and `TEAMID`.",
-				Required:            true,
-				Validators: []validator.String{
-					stringvalidator.OneOf([]string{"BINARY", "CDHASH", "CERTIFICATE", "SIGNINGID", "TEAMID"}...),
-				},
-			},
-			"target_identifier": schema.StringAttribute{
-				Description:         "Target identifier: binary, certificate sha256, signing ID or team ID.",
-				MarkdownDescription: "Target identifier: binary, certificate sha256, signing ID or team ID.",
-				Required:            true,
-			},
-			"description": schema.StringAttribute{
-				Description:         "Description of the rule. Only displayed in the Zentral GUI.",
-				MarkdownDescription: "Description of the rule. Only displayed in the Zentral GUI.",
-				Optional:            true,
-				Computed:            true,
-				Default:             stringdefault.StaticString(""),
+// santaRuleAttributes returns the attributes of a Santa rule, shared by the
+// zentral_santa_rule resource and the rules of the zentral_santa_rules_bulk resource.
+func santaRuleAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"policy": schema.StringAttribute{
+			Description:         "Policy. Valid values are ALLOWLIST, ALLOWLIST_COMPILER, BLOCKLIST, CEL, and SILENT_BLOCKLIST.",
+			MarkdownDescription: "Policy. Valid values are `ALLOWLIST`, `ALLOWLIST_COMPILER`, `BLOCKLIST`, `CEL`, and `SILENT_BLOCKLIST`.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf([]string{tfSantaAllowlist, tfSantaAllowlistCompiler, tfSantaCEL, tfSantaBlocklist, tfSantaSilentBlocklist}...),
+				santaRulePolicyValidator{},
 			},
-			"custom_message": schema.StringAttribute{
-				Description:         "Custom message displayed in the popover when a binary is blocked.",
-				MarkdownDescription: "Custom message displayed in the popover when a binary is blocked.",
-				Optional:            true,
-				Computed:            true,
-				Default:             stringdefault.StaticString(""),
-			},
-			"custom_url": schema.StringAttribute{
-				Description:         "Custom URL the user can visit for more information when blocked.",
-				MarkdownDescription: "Custom URL the user can visit for more information when blocked.",
-				Optional:            true,
-				Computed:            true,
-				Default:             stringdefault.StaticString(""),
-			},
-			"ruleset_id": schema.Int64Attribute{
-				Description:         "ID of the Santa ruleset.",
-				MarkdownDescription: "`ID` of the Santa ruleset.",
-				Computed:            true,
-			},
-			"primary_users": schema.SetAttribute{
-				Description:         "The primary users used to scope the rule.",
-				MarkdownDescription: "The primary users used to scope the rule.",
-				ElementType:         types.StringType,
-				Optional:            true,
-				Computed:            true,
-				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
-			},
-			"excluded_primary_users": schema.SetAttribute{
-				Description:         "The excluded primary users used to scope the rule.",
-				MarkdownDescription: "The excluded primary users used to scope the rule.",
-				ElementType:         types.StringType,
-				Optional:            true,
-				Computed:            true,
-				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
-			},
-			"serial_numbers": schema.SetAttribute{
-				Description:         "The serial numbers used to scope the rule.",
-				MarkdownDescription: "The serial numbers used to scope the rule.",
-				ElementType:         types.StringType,
-				Optional:            true,
-				Computed:            true,
-				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
-			},
-			"excluded_serial_numbers": schema.SetAttribute{
-				Description:         "The excluded serial numbers used to scope the rule.",
-				MarkdownDescription: "The excluded serial numbers used to scope the rule.",
-				ElementType:         types.StringType,
-				Optional:            true,
-				Computed:            true,
-				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
-			},
-			"tag_ids": schema.SetAttribute{
-				Description:         "The IDs of the tags used to scope the rule.",
-				MarkdownDescription: "The `ID`s of the tags used to scope the rule.",
-				ElementType:         types.Int64Type,
-				Optional:            true,
-				Computed:            true,
-				Default:             setdefault.StaticValue(types.SetValueMust(types.Int64Type, []attr.Value{})),
+		},
+		"cel_expr": schema.StringAttribute{
+			Description:         "CEL expression. Required for, and only valid for `CEL` policy rules.",
+			MarkdownDescription: "CEL expression. Required for, and only valid for `CEL` policy rules.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(""),
+			Validators: []validator.String{
+				santaRuleCELExprValidator(),
 			},
-			"excluded_tag_ids": schema.SetAttribute{
-				Description:         "The IDs of the excluded tags used to scope the rule.",
-				MarkdownDescription: "The `ID`s of the excluded tags used to scope the rule.",
-				ElementType:         types.Int64Type,
-				Optional:            true,
-				Computed:            true,
-				Default:             setdefault.StaticValue(types.SetValueMust(types.Int64Type, []attr.Value{})),
+		},
+		"target_type": schema.StringAttribute{
+			Description:         "Target type. Valid values are BINARY, CDHASH, CERTIFICATE, SIGNINGID and TEAMID.",
+			MarkdownDescription: "Target type. Valid values are `BINARY`, `CDHASH`, `CERTIFICATE`, `SIGNINGID` and `TEAMID`.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf([]string{"BINARY", "CDHASH", "CERTIFICATE", "SIGNINGID", "TEAMID"}...),
 			},
-			"version": schema.Int64Attribute{
-				Description:         "Rule version.",
-				MarkdownDescription: "Rule version.",
-				Computed:            true,
+		},
+		"target_identifier": schema.StringAttribute{
+			Description:         "Target identifier: binary or certificate sha256, CD hash, signing ID or team ID.",
+			MarkdownDescription: "Target identifier: binary or certificate sha256, CD hash, signing ID or team ID.",
+			Required:            true,
+			Validators: []validator.String{
+				santaRuleTargetIdentifierValidator{},
 			},
 		},
+		"description": schema.StringAttribute{
+			Description:         "Description of the rule. Only displayed in the Zentral GUI.",
+			MarkdownDescription: "Description of the rule. Only displayed in the Zentral GUI.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(""),
+		},
+		"custom_message": schema.StringAttribute{
+			Description:         "Custom message displayed in the popover when a binary is blocked. Not valid for ALLOWLIST and ALLOWLIST_COMPILER policy rules.",
+			MarkdownDescription: "Custom message displayed in the popover when a binary is blocked. Not valid for `ALLOWLIST` and `ALLOWLIST_COMPILER` policy rules.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(""),
+		},
+		"custom_url": schema.StringAttribute{
+			Description:         "Custom URL the user can visit for more information when blocked. Not valid for ALLOWLIST and ALLOWLIST_COMPILER policy rules.",
+			MarkdownDescription: "Custom URL the user can visit for more information when blocked. Not valid for `ALLOWLIST` and `ALLOWLIST_COMPILER` policy rules.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(""),
+		},
+		"primary_users": schema.SetAttribute{
+			Description:         "The primary users used to scope the rule.",
+			MarkdownDescription: "The primary users used to scope the rule.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Computed:            true,
+			Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+		},
+		"excluded_primary_users": schema.SetAttribute{
+			Description:         "The excluded primary users used to scope the rule.",
+			MarkdownDescription: "The excluded primary users used to scope the rule.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Computed:            true,
+			Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+		},
+		"serial_numbers": schema.SetAttribute{
+			Description:         "The serial numbers used to scope the rule.",
+			MarkdownDescription: "The serial numbers used to scope the rule.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Computed:            true,
+			Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+		},
+		"excluded_serial_numbers": schema.SetAttribute{
+			Description:         "The excluded serial numbers used to scope the rule.",
+			MarkdownDescription: "The excluded serial numbers used to scope the rule.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Computed:            true,
+			Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+		},
+		"tag_ids": schema.SetAttribute{
+			Description:         "The IDs of the tags used to scope the rule.",
+			MarkdownDescription: "The `ID`s of the tags used to scope the rule.",
+			ElementType:         types.Int64Type,
+			Optional:            true,
+			Computed:            true,
+			Default:             setdefault.StaticValue(types.SetValueMust(types.Int64Type, []attr.Value{})),
+		},
+		"excluded_tag_ids": schema.SetAttribute{
+			Description:         "The IDs of the excluded tags used to scope the rule.",
+			MarkdownDescription: "The `ID`s of the excluded tags used to scope the rule.",
+			ElementType:         types.Int64Type,
+			Optional:            true,
+			Computed:            true,
+			Default:             setdefault.StaticValue(types.SetValueMust(types.Int64Type, []attr.Value{})),
+		},
+	}
+}
+
+func (r *SantaRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := santaRuleAttributes()
+	attributes["id"] = schema.Int64Attribute{
+		Description:         "ID of the Santa rule.",
+		MarkdownDescription: "`ID` of the Santa rule.",
+		Computed:            true,
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["configuration_id"] = schema.Int64Attribute{
+		Description:         "ID of the Santa configuration.",
+		MarkdownDescription: "`ID` of the Santa configuration.",
+		Required:            true,
+	}
+	attributes["ruleset_id"] = schema.Int64Attribute{
+		Description:         "ID of the Santa ruleset.",
+		MarkdownDescription: "`ID` of the Santa ruleset.",
+		Computed:            true,
+	}
+	attributes["version"] = schema.Int64Attribute{
+		Description:         "Rule version.",
+		MarkdownDescription: "Rule version.",
+		Computed:            true,
+	}
+	resp.Schema = schema.Schema{
+		Description:         "Manages Santa rules.",
+		MarkdownDescription: "The resource `zentral_santa_rule` manages Santa rules.",
+
+		Attributes: attributes,
 	}
 }
 
@@ -220,8 +236,13 @@ func (r *SantaRuleResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	ztlSR, _, err := r.client.SantaRules.GetByID(ctx, int(data.ID.ValueInt64()))
+	ztlSR, ztlResp, err := r.client.SantaRules.GetByID(ctx, int(data.ID.ValueInt64()))
 	if err != nil {
+		if isNotFound(ztlResp, err) {
+			tflog.Warn(ctx, "Santa rule not found, removing it from the state", map[string]interface{}{"id": data.ID.ValueInt64()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Client error",
 			fmt.Sprintf("Unable to read Santa rule %d, got error: %s", data.ID.ValueInt64(), err),
@@ -283,5 +304,24 @@ func (r *SantaRuleResource) Delete(ctx context.Context, req resource.DeleteReque
 }
 
 func (r *SantaRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resourceImportStatePassthroughZentralID(ctx, "Santa rule", req, resp)
+	resourceImportStateZentralIDOrKey(ctx, "Santa rule", "<configuration_id>/<target_type>/<target_identifier>", req, resp, func(ctx context.Context, key []string) (int, error) {
+		configurationID, err := parseImportKeyID("configuration_id", key[0])
+		if err != nil {
+			return 0, err
+		}
+		rules, _, err := listSantaRules(ctx, r.client, url.Values{
+			"configuration_id":  {strconv.Itoa(configurationID)},
+			"target_type":       {key[1]},
+			"target_identifier": {key[2]},
+		})
+		if err != nil {
+			return 0, err
+		}
+		for _, rule := range rules {
+			if rule.ConfigurationID == configurationID && rule.TargetType == key[1] && rule.TargetIdentifier == key[2] {
+				return rule.ID, nil
+			}
+		}
+		return 0, errImportKeyNotFound
+	})
 }
@@ -1,11 +1,14 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"strconv"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/zentralopensource/goztl"
 )
 
 func TestAccSantaRuleResource(t *testing.T) {
@@ -129,6 +132,28 @@ func TestAccSantaRuleResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// ImportState by natural key
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportStateIDFunc(resourceName, "configuration_id", "target_type", "target_identifier"),
+				ImportStateVerify: true,
+			},
+			// Deleted outside of Terraform
+			{
+				Config: testAccSantaRuleResourceConfigFull(name, tagName, tag2Name),
+				Check: testAccCheckResourceDisappears(
+					resourceName,
+					func(ctx context.Context, c *goztl.Client, id string) (*goztl.Response, error) {
+						ztlID, err := strconv.Atoi(id)
+						if err != nil {
+							return nil, err
+						}
+						return c.SantaRules.Delete(ctx, ztlID)
+					},
+				),
+				ExpectNonEmptyPlan: true,
+			},
 		},
 	})
 }
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSantaRuleFiltersMatch(t *testing.T) {
+	sr := santaRule{
+		ConfigurationID:  types.Int64Value(3),
+		Policy:           types.StringValue(tfSantaBlocklist),
+		TargetType:       types.StringValue("TEAMID"),
+		TargetIdentifier: types.StringValue("EQHXZ8M8AV"),
+		RulesetID:        types.Int64Null(),
+		TagIDs:           types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(7), types.Int64Value(8)}),
+	}
+	sr.ID = types.Int64Value(17)
+	managedSR := sr
+	managedSR.RulesetID = types.Int64Value(1)
+	cases := []struct {
+		filters  santaRuleFilters
+		sr       santaRule
+		expected bool
+	}{
+		{santaRuleFilters{}, sr, true},
+		{santaRuleFilters{ConfigurationID: types.Int64Value(3)}, sr, true},
+		{santaRuleFilters{ConfigurationID: types.Int64Value(4)}, sr, false},
+		{santaRuleFilters{Policy: types.StringValue(tfSantaBlocklist)}, sr, true},
+		{santaRuleFilters{Policy: types.StringValue(tfSantaSilentBlocklist)}, sr, false},
+		{santaRuleFilters{TargetType: types.StringValue("TEAMID"), TargetIdentifier: types.StringValue("EQHXZ8M8AV")}, sr, true},
+		{santaRuleFilters{TargetType: types.StringValue("SIGNINGID")}, sr, false},
+		{santaRuleFilters{TargetIdentifier: types.StringValue("MLF9FE35AM")}, sr, false},
+		{santaRuleFilters{TagID: types.Int64Value(8)}, sr, true},
+		{santaRuleFilters{TagID: types.Int64Value(9)}, sr, false},
+		{santaRuleFilters{RulesetManaged: types.BoolValue(false)}, sr, true},
+		{santaRuleFilters{RulesetManaged: types.BoolValue(true)}, sr, false},
+		{santaRuleFilters{RulesetManaged: types.BoolValue(true)}, managedSR, true},
+		{santaRuleFilters{RulesetManaged: types.BoolValue(false)}, managedSR, false},
+	}
+	for _, c := range cases {
+		if got := c.filters.match(c.sr); got != c.expected {
+			t.Errorf("%+v: expected %t, got %t", c.filters, c.expected, got)
+		}
+	}
+}
+
+func TestSantaRuleFiltersQuery(t *testing.T) {
+	filters := santaRuleFilters{
+		ConfigurationID:  types.Int64Value(3),
+		Policy:           types.StringValue(tfSantaBlocklist),
+		TargetType:       types.StringValue("TEAMID"),
+		TargetIdentifier: types.StringValue("EQHXZ8M8AV"),
+	}
+	expected := "configuration_id=3&target_identifier=EQHXZ8M8AV&target_type=TEAMID"
+	if got := filters.query().Encode(); got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+	if got := (santaRuleFilters{}).query().Encode(); got != "" {
+		t.Errorf("expected empty query, got %q", got)
+	}
+}
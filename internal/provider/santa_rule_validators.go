@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	santaRuleSHA256Re    = regexp.MustCompile(`^[0-9a-f]{64}$`)
+	santaRuleCDHashRe    = regexp.MustCompile(`^[0-9a-f]{40}$`)
+	santaRuleTeamIDRe    = regexp.MustCompile(`^[0-9A-Z]{10}$`)
+	santaRuleSigningIDRe = regexp.MustCompile(`^([0-9A-Z]{10}|platform):\S+$`)
+)
+
+// validateSantaRuleTargetIdentifier verifies the format of a target
+// identifier, and returns an error message if it is invalid.
+func validateSantaRuleTargetIdentifier(targetType string, targetIdentifier string) string {
+	switch targetType {
+	case "BINARY", "CERTIFICATE":
+		if !santaRuleSHA256Re.MatchString(targetIdentifier) {
+			if santaRuleSHA256Re.MatchString(strings.ToLower(targetIdentifier)) {
+				return fmt.Sprintf("A %s target identifier must be a lowercase SHA-256 hash.", targetType)
+			}
+			return fmt.Sprintf("A %s target identifier must be a SHA-256 hash of 64 hexadecimal characters.", targetType)
+		}
+	case "CDHASH":
+		if !santaRuleCDHashRe.MatchString(targetIdentifier) {
+			if santaRuleCDHashRe.MatchString(strings.ToLower(targetIdentifier)) {
+				return "A CDHASH target identifier must be lowercase."
+			}
+			return "A CDHASH target identifier must be 40 hexadecimal characters."
+		}
+	case "TEAMID":
+		if !santaRuleTeamIDRe.MatchString(targetIdentifier) {
+			return "A TEAMID target identifier must be a team ID of 10 uppercase letters or digits, like EQHXZ8M8AV."
+		}
+	case "SIGNINGID":
+		if !santaRuleSigningIDRe.MatchString(targetIdentifier) {
+			return "A SIGNINGID target identifier must be a team ID or platform, followed by a colon and the signing ID, like EQHXZ8M8AV:com.google.Chrome or platform:com.apple.curl."
+		}
+	}
+	return ""
+}
+
+// Target identifier validator
+
+var _ validator.String = santaRuleTargetIdentifierValidator{}
+
+// santaRuleTargetIdentifierValidator verifies the format of the target
+// identifier, using the target_type attribute of the same rule.
+type santaRuleTargetIdentifierValidator struct{}
+
+func (v santaRuleTargetIdentifierValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v santaRuleTargetIdentifierValidator) MarkdownDescription(_ context.Context) string {
+	return "value must be a valid identifier for the target type"
+}
+
+func (v santaRuleTargetIdentifierValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var targetType types.String
+	diags := req.Config.GetAttribute(ctx, req.Path.ParentPath().AtName("target_type"), &targetType)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() || targetType.IsNull() || targetType.IsUnknown() {
+		return
+	}
+
+	if problem := validateSantaRuleTargetIdentifier(targetType.ValueString(), req.ConfigValue.ValueString()); problem != "" {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid target identifier", problem)
+	}
+}
+
+// Policy validator
+
+var _ validator.String = santaRulePolicyValidator{}
+
+// santaRulePolicyValidator verifies that the CEL expression is only set, and
+// always set, for the CEL policy, and that the custom message and URL are only
+// set for the policies that can block a binary.
+type santaRulePolicyValidator struct{}
+
+func (v santaRulePolicyValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v santaRulePolicyValidator) MarkdownDescription(_ context.Context) string {
+	return "cel_expr must be set only for the CEL policy, and custom_message and custom_url only for the BLOCKLIST, SILENT_BLOCKLIST and CEL policies"
+}
+
+func (v santaRulePolicyValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	policy := req.ConfigValue.ValueString()
+
+	attrs := make(map[string]types.String)
+	for _, attrName := range []string{"cel_expr", "custom_message", "custom_url"} {
+		var value types.String
+		diags := req.Config.GetAttribute(ctx, req.Path.ParentPath().AtName(attrName), &value)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			return
+		}
+		attrs[attrName] = value
+	}
+	// null or empty → not set, because of the default values
+	isSet := func(attrName string) bool {
+		return !attrs[attrName].IsUnknown() && attrs[attrName].ValueString() != ""
+	}
+
+	celExpr := attrs["cel_expr"]
+	if policy == tfSantaCEL {
+		if !celExpr.IsUnknown() && celExpr.ValueString() == "" {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.ParentPath().AtName("cel_expr"),
+				"Missing CEL expression",
+				"cel_expr is required for the CEL policy.",
+			)
+		}
+	} else if isSet("cel_expr") {
+		resp.Diagnostics.AddAttributeError(
+			req.Path.ParentPath().AtName("cel_expr"),
+			"Invalid CEL expression",
+			fmt.Sprintf("cel_expr can only be set for the CEL policy, not for the %s policy.", policy),
+		)
+	}
+
+	if policy == tfSantaAllowlist || policy == tfSantaAllowlistCompiler {
+		for _, attrName := range []string{"custom_message", "custom_url"} {
+			if isSet(attrName) {
+				resp.Diagnostics.AddAttributeError(
+					req.Path.ParentPath().AtName(attrName),
+					"Invalid Santa rule",
+					fmt.Sprintf("%s can only be set for the BLOCKLIST, SILENT_BLOCKLIST and CEL policies, not for the %s policy.", attrName, policy),
+				)
+			}
+		}
+	}
+}
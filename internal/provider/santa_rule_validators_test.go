@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestValidateSantaRuleTargetIdentifier(t *testing.T) {
+	cases := []struct {
+		targetType       string
+		targetIdentifier string
+		problem          string
+	}{
+		{"BINARY", "2e6b5d9d1e8f5f2b3cbb8a9e3a1f4c7d6e5b4a39281706f5e4d3c2b1a0f9e8d7", ""},
+		{"BINARY", "2E6B5D9D1E8F5F2B3CBB8A9E3A1F4C7D6E5B4A39281706F5E4D3C2B1A0F9E8D7", "A BINARY target identifier must be a lowercase SHA-256 hash."},
+		{"CERTIFICATE", "2e6b5d9d", "A CERTIFICATE target identifier must be a SHA-256 hash of 64 hexadecimal characters."},
+		{"CDHASH", "9f3e7b21a0a745297dd906dad4a4a4637bdec066", ""},
+		{"CDHASH", "9F3E7B21A0A745297DD906DAD4A4A4637BDEC066", "A CDHASH target identifier must be lowercase."},
+		{"CDHASH", "2e6b5d9d1e8f5f2b3cbb8a9e3a1f4c7d6e5b4a39281706f5e4d3c2b1a0f9e8d7", "A CDHASH target identifier must be 40 hexadecimal characters."},
+		{"TEAMID", "EQHXZ8M8AV", ""},
+		{"TEAMID", "eqhxz8m8av", "A TEAMID target identifier must be a team ID of 10 uppercase letters or digits"},
+		{"SIGNINGID", "EQHXZ8M8AV:com.google.Chrome", ""},
+		{"SIGNINGID", "platform:com.apple.curl", ""},
+		{"SIGNINGID", "com.google.Chrome", "A SIGNINGID target identifier must be a team ID or platform"},
+		{"SIGNINGID", "EQHXZ8M8AV:", "A SIGNINGID target identifier must be a team ID or platform"},
+	}
+	for _, c := range cases {
+		problem := validateSantaRuleTargetIdentifier(c.targetType, c.targetIdentifier)
+		if (c.problem == "") != (problem == "") || !strings.HasPrefix(problem, c.problem) {
+			t.Errorf("%s %q: expected %q, got %q", c.targetType, c.targetIdentifier, c.problem, problem)
+		}
+	}
+}
+
+func testSantaRuleConfig(values map[string]tftypes.Value) tfsdk.Config {
+	ctx := context.Background()
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"policy":            schema.StringAttribute{Required: true},
+			"cel_expr":          schema.StringAttribute{Optional: true},
+			"target_type":       schema.StringAttribute{Required: true},
+			"target_identifier": schema.StringAttribute{Required: true},
+			"custom_message":    schema.StringAttribute{Optional: true},
+			"custom_url":        schema.StringAttribute{Optional: true},
+		},
+	}
+	objType := s.Type().TerraformType(ctx).(tftypes.Object)
+	rawValues := make(map[string]tftypes.Value)
+	for name := range objType.AttributeTypes {
+		if v, ok := values[name]; ok {
+			rawValues[name] = v
+		} else {
+			rawValues[name] = tftypes.NewValue(tftypes.String, nil)
+		}
+	}
+	return tfsdk.Config{Schema: s, Raw: tftypes.NewValue(objType, rawValues)}
+}
+
+func TestSantaRuleValidators(t *testing.T) {
+	cases := []struct {
+		name   string
+		values map[string]interface{}
+		errors []string
+	}{
+		{
+			"allowlist",
+			map[string]interface{}{"policy": "ALLOWLIST", "target_type": "TEAMID", "target_identifier": "EQHXZ8M8AV", "cel_expr": ""},
+			nil,
+		},
+		{
+			"blocklist",
+			map[string]interface{}{
+				"policy":            "BLOCKLIST",
+				"target_type":       "SIGNINGID",
+				"target_identifier": "platform:com.apple.curl",
+				"custom_message":    "Blocked!",
+				"custom_url":        "https://zentral.com",
+			},
+			nil,
+		},
+		{
+			"cel",
+			map[string]interface{}{
+				"policy":            "CEL",
+				"target_type":       "CDHASH",
+				"target_identifier": "9f3e7b21a0a745297dd906dad4a4a4637bdec066",
+				"cel_expr":          "target.signing_time >= timestamp('2025-05-31T00:00:00Z')",
+				"custom_message":    "Blocked!",
+			},
+			nil,
+		},
+		{
+			"unknown values",
+			map[string]interface{}{
+				"policy":            "CEL",
+				"target_type":       tftypes.UnknownValue,
+				"target_identifier": "yolo",
+				"cel_expr":          tftypes.UnknownValue,
+			},
+			nil,
+		},
+		{
+			"invalid target identifier",
+			map[string]interface{}{"policy": "BLOCKLIST", "target_type": "TEAMID", "target_identifier": "EQHXZ8M8A"},
+			[]string{"A TEAMID target identifier must be a team ID of 10 uppercase letters or digits"},
+		},
+		{
+			"cel without expression",
+			map[string]interface{}{"policy": "CEL", "target_type": "TEAMID", "target_identifier": "EQHXZ8M8AV"},
+			[]string{"cel_expr is required for the CEL policy."},
+		},
+		{
+			"expression without cel",
+			map[string]interface{}{
+				"policy":            "BLOCKLIST",
+				"target_type":       "TEAMID",
+				"target_identifier": "EQHXZ8M8AV",
+				"cel_expr":          "true",
+			},
+			[]string{"cel_expr can only be set for the CEL policy, not for the BLOCKLIST policy."},
+		},
+		{
+			"allowlist with custom message and url",
+			map[string]interface{}{
+				"policy":            "ALLOWLIST_COMPILER",
+				"target_type":       "TEAMID",
+				"target_identifier": "EQHXZ8M8AV",
+				"custom_message":    "Allowed!",
+				"custom_url":        "https://zentral.com",
+			},
+			[]string{
+				"custom_message can only be set for the BLOCKLIST, SILENT_BLOCKLIST and CEL policies, not for the ALLOWLIST_COMPILER policy.",
+				"custom_url can only be set for the BLOCKLIST, SILENT_BLOCKLIST and CEL policies, not for the ALLOWLIST_COMPILER policy.",
+			},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.Background()
+			rawValues := make(map[string]tftypes.Value)
+			for name, v := range c.values {
+				rawValues[name] = tftypes.NewValue(tftypes.String, v)
+			}
+			config := testSantaRuleConfig(rawValues)
+			resp := &validator.StringResponse{}
+			for attrName, v := range map[string]validator.String{
+				"policy":            santaRulePolicyValidator{},
+				"target_identifier": santaRuleTargetIdentifierValidator{},
+			} {
+				var value types.String
+				config.GetAttribute(ctx, path.Root(attrName), &value)
+				req := validator.StringRequest{Path: path.Root(attrName), ConfigValue: value, Config: config}
+				v.ValidateString(ctx, req, resp)
+			}
+			got := testDiagnosticDetails(resp.Diagnostics.Errors())
+			if len(got) != len(c.errors) {
+				t.Fatalf("expected %d error(s), got %q", len(c.errors), got)
+			}
+			for i, detail := range got {
+				if !strings.HasPrefix(detail, c.errors[i]) {
+					t.Errorf("expected error %q, got %q", c.errors[i], detail)
+				}
+			}
+		})
+	}
+}
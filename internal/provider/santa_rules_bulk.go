@@ -0,0 +1,221 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zentralopensource/goztl"
+)
+
+const (
+	// Number of Santa rule API calls made before checking for errors.
+	santaRulesBulkBatchSize = 100
+	// Number of concurrent Santa rule API calls in a batch.
+	santaRulesBulkConcurrency = 8
+)
+
+type santaRulesBulk struct {
+	ID              types.Int64 `tfsdk:"id"`
+	ConfigurationID types.Int64 `tfsdk:"configuration_id"`
+	Rules           types.Set   `tfsdk:"rules"`
+	RuleIDs         types.Map   `tfsdk:"rule_ids"`
+}
+
+// santaRulesBulkRuleAttrTypes are the types of the shared Santa rule attributes.
+var santaRulesBulkRuleAttrTypes = makeSantaRulesBulkRuleAttrTypes()
+
+func makeSantaRulesBulkRuleAttrTypes() map[string]attr.Type {
+	attrTypes := make(map[string]attr.Type)
+	for name, attribute := range santaRuleAttributes() {
+		attrTypes[name] = attribute.GetType()
+	}
+	return attrTypes
+}
+
+// santaRulesBulkRuleKey returns the key of a rule in a configuration, like the
+// one used to import a zentral_santa_rule resource.
+func santaRulesBulkRuleKey(targetType string, targetIdentifier string) string {
+	return targetType + "/" + targetIdentifier
+}
+
+// santaRulesBulkObjectKey returns the key of a rule object, and false if the
+// target is not known yet.
+func santaRulesBulkObjectKey(rule types.Object) (string, bool) {
+	attrs := rule.Attributes()
+	targetType, _ := attrs["target_type"].(types.String)
+	targetIdentifier, _ := attrs["target_identifier"].(types.String)
+	if targetType.IsNull() || targetType.IsUnknown() || targetIdentifier.IsNull() || targetIdentifier.IsUnknown() {
+		return "", false
+	}
+	return santaRulesBulkRuleKey(targetType.ValueString(), targetIdentifier.ValueString()), true
+}
+
+func santaRulesBulkRuleForState(sr *goztl.SantaRule) types.Object {
+	data := santaRuleForState(sr)
+	return types.ObjectValueMust(
+		santaRulesBulkRuleAttrTypes,
+		map[string]attr.Value{
+			"policy":                  data.Policy,
+			"cel_expr":                data.CELExpr,
+			"target_type":             data.TargetType,
+			"target_identifier":       data.TargetIdentifier,
+			"description":             data.Description,
+			"custom_message":          data.CustomMessage,
+			"custom_url":              data.CustomURL,
+			"primary_users":           data.PrimaryUsers,
+			"excluded_primary_users":  data.ExcludedPrimaryUsers,
+			"serial_numbers":          data.SerialNumbers,
+			"excluded_serial_numbers": data.ExcludedSerialNumbers,
+			"tag_ids":                 data.TagIDs,
+			"excluded_tag_ids":        data.ExcludedTagIDs,
+		},
+	)
+}
+
+func santaRulesBulkRuleRequestWithState(configurationID types.Int64, rule types.Object) *goztl.SantaRuleRequest {
+	oMap := rule.Attributes()
+	return santaRuleRequestWithState(santaRule{
+		ConfigurationID:       configurationID,
+		Policy:                oMap["policy"].(types.String),
+		CELExpr:               oMap["cel_expr"].(types.String),
+		TargetType:            oMap["target_type"].(types.String),
+		TargetIdentifier:      oMap["target_identifier"].(types.String),
+		Description:           oMap["description"].(types.String),
+		CustomMessage:         oMap["custom_message"].(types.String),
+		CustomURL:             oMap["custom_url"].(types.String),
+		PrimaryUsers:          oMap["primary_users"].(types.Set),
+		ExcludedPrimaryUsers:  oMap["excluded_primary_users"].(types.Set),
+		SerialNumbers:         oMap["serial_numbers"].(types.Set),
+		ExcludedSerialNumbers: oMap["excluded_serial_numbers"].(types.Set),
+		TagIDs:                oMap["tag_ids"].(types.Set),
+		ExcludedTagIDs:        oMap["excluded_tag_ids"].(types.Set),
+	})
+}
+
+// santaRulesBulkEntry is a managed rule, with the ID of the Zentral rule.
+type santaRulesBulkEntry struct {
+	ID   int64
+	Rule types.Object
+}
+
+// santaRulesBulkEntriesWithState returns the managed rules by key. The
+// rules without IDs, like the planned ones, have a 0 ID.
+func santaRulesBulkEntriesWithState(data santaRulesBulk) map[string]santaRulesBulkEntry {
+	ruleIDs := data.RuleIDs.Elements() // nil if null or unknown
+	entries := make(map[string]santaRulesBulkEntry)
+	for _, elem := range data.Rules.Elements() { // nil if null or unknown → no iterations
+		rule, ok := elem.(types.Object)
+		if !ok {
+			continue
+		}
+		key, ok := santaRulesBulkObjectKey(rule)
+		if !ok {
+			continue
+		}
+		var id int64
+		if ruleID, ok := ruleIDs[key].(types.Int64); ok {
+			id = ruleID.ValueInt64()
+		}
+		entries[key] = santaRulesBulkEntry{ID: id, Rule: rule}
+	}
+	return entries
+}
+
+func santaRulesBulkForState(id types.Int64, configurationID types.Int64, entries map[string]santaRulesBulkEntry) santaRulesBulk {
+	rules := make([]attr.Value, 0, len(entries))
+	ruleIDs := make(map[string]attr.Value, len(entries))
+	for _, key := range sortedKeys(entries) {
+		rules = append(rules, entries[key].Rule)
+		ruleIDs[key] = types.Int64Value(entries[key].ID)
+	}
+	return santaRulesBulk{
+		ID:              id,
+		ConfigurationID: configurationID,
+		Rules:           types.SetValueMust(types.ObjectType{AttrTypes: santaRulesBulkRuleAttrTypes}, rules),
+		RuleIDs:         types.MapValueMust(types.Int64Type, ruleIDs),
+	}
+}
+
+// diffSantaRulesBulk returns the sorted keys of the rules to create, update and
+// delete to go from the current rules to the desired ones.
+func diffSantaRulesBulk(current map[string]santaRulesBulkEntry, desired map[string]santaRulesBulkEntry) (creates []string, updates []string, deletes []string) {
+	for _, key := range sortedKeys(desired) {
+		if c, ok := current[key]; !ok {
+			creates = append(creates, key)
+		} else if !c.Rule.Equal(desired[key].Rule) {
+			updates = append(updates, key)
+		}
+	}
+	for _, key := range sortedKeys(current) {
+		if _, ok := desired[key]; !ok {
+			deletes = append(deletes, key)
+		}
+	}
+	return creates, updates, deletes
+}
+
+// runSantaRulesBulkBatches calls f for each key, in sequential batches of
+// concurrent calls, and stops after the first batch with errors. It returns
+// the errors by key.
+func runSantaRulesBulkBatches(ctx context.Context, keys []string, batchSize int, concurrency int, f func(context.Context, string) error) map[string]error {
+	errs := make(map[string]error)
+	var mu sync.Mutex
+	for start := 0; start < len(keys); start += batchSize {
+		if err := ctx.Err(); err != nil {
+			for _, key := range keys[start:] {
+				errs[key] = err
+			}
+			return errs
+		}
+		end := min(start+batchSize, len(keys))
+		sem := make(chan struct{}, concurrency)
+		var wg sync.WaitGroup
+		for _, key := range keys[start:end] {
+			wg.Add(1)
+			sem <- struct{}{}
+			go func(key string) {
+				defer wg.Done()
+				defer func() { <-sem }()
+				if err := f(ctx, key); err != nil {
+					mu.Lock()
+					errs[key] = err
+					mu.Unlock()
+				}
+			}(key)
+		}
+		wg.Wait()
+		if len(errs) > 0 {
+			return errs
+		}
+	}
+	return errs
+}
+
+// parseSantaRulesBulkImportID parses a <configuration_id> or a
+// <configuration_id>/<rule_id>,<rule_id>,… import ID, and returns the
+// configuration ID and the IDs of the rules to manage. The rule IDs are nil
+// if all the rules of the configuration must be managed.
+func parseSantaRulesBulkImportID(id string) (int64, []int64, error) {
+	cfgPart, rulesPart, hasRules := strings.Cut(id, "/")
+	configurationID, err := strconv.ParseInt(cfgPart, 10, 64)
+	if err != nil {
+		return 0, nil, fmt.Errorf("configuration_id must be an integer")
+	}
+	if !hasRules {
+		return configurationID, nil, nil
+	}
+	ruleIDs := make([]int64, 0)
+	for _, part := range strings.Split(rulesPart, ",") {
+		ruleID, err := strconv.ParseInt(strings.TrimSpace(part), 10, 64)
+		if err != nil {
+			return 0, nil, fmt.Errorf("rule IDs must be integers")
+		}
+		ruleIDs = append(ruleIDs, ruleID)
+	}
+	return configurationID, ruleIDs, nil
+}
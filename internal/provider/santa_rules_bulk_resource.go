@@ -0,0 +1,339 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/zentralopensource/goztl"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &SantaRulesBulkResource{}
+var _ resource.ResourceWithImportState = &SantaRulesBulkResource{}
+var _ resource.ResourceWithValidateConfig = &SantaRulesBulkResource{}
+
+func NewSantaRulesBulkResource() resource.Resource {
+	return &SantaRulesBulkResource{}
+}
+
+// SantaRulesBulkResource defines the resource implementation.
+type SantaRulesBulkResource struct {
+	client *goztl.Client
+}
+
+func (r *SantaRulesBulkResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_santa_rules_bulk"
+}
+
+func (r *SantaRulesBulkResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the Santa rules of a configuration in bulk. " +
+			"This is not a Zentral Santa ruleset: the rules are created one by one, and are not attached to a ruleset. " +
+			"Only the rules created by the resource, or adopted when it was imported, are managed.",
+		MarkdownDescription: "The resource `zentral_santa_rules_bulk` manages the Santa rules of a configuration in bulk. " +
+			"This is **not** a Zentral Santa ruleset: the rules are created one by one, and are not attached to a ruleset. " +
+			"Only the rules created by the resource, or adopted when it was imported, are managed. " +
+			"A rule must not be managed by both a `zentral_santa_rules_bulk` and a `zentral_santa_rule` resource.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description:         "ID of the resource. Same as configuration_id.",
+				MarkdownDescription: "`ID` of the resource. Same as `configuration_id`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"configuration_id": schema.Int64Attribute{
+				Description:         "ID of the Santa configuration.",
+				MarkdownDescription: "`ID` of the Santa configuration.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"rules": schema.SetNestedAttribute{
+				Description:         "Rules of the configuration. A target can only have one rule.",
+				MarkdownDescription: "Rules of the configuration. A target can only have one rule.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: santaRuleAttributes(),
+				},
+				Required: true,
+			},
+			"rule_ids": schema.MapAttribute{
+				Description:         "IDs of the Santa rules, by <target_type>/<target_identifier> key.",
+				MarkdownDescription: "`ID`s of the Santa rules, by `<target_type>/<target_identifier>` key.",
+				ElementType:         types.Int64Type,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *SantaRulesBulkResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*goztl.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *goztl.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SantaRulesBulkResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var rules types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("rules"), &rules)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seenKeys := make(map[string]bool)
+	for _, elem := range rules.Elements() { // nil if null or unknown → no iterations
+		rule, ok := elem.(types.Object)
+		if !ok || rule.IsNull() || rule.IsUnknown() {
+			continue
+		}
+		key, ok := santaRulesBulkObjectKey(rule)
+		if !ok {
+			continue
+		}
+		if seenKeys[key] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("rules"),
+				"Duplicate Santa rule",
+				fmt.Sprintf("The target %s has more than one rule.", key),
+			)
+		}
+		seenKeys[key] = true
+	}
+}
+
+// apply creates, updates and deletes the Santa rules to go from the current
+// rules to the desired ones, and returns the rules that were applied, to be
+// saved in the state even if some API calls failed.
+func (r *SantaRulesBulkResource) apply(ctx context.Context, configurationID types.Int64, current map[string]santaRulesBulkEntry, desired map[string]santaRulesBulkEntry) (map[string]santaRulesBulkEntry, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	creates, updates, deletes := diffSantaRulesBulk(current, desired)
+
+	applied := make(map[string]santaRulesBulkEntry, len(current))
+	for key, entry := range current {
+		applied[key] = entry
+	}
+	var mu sync.Mutex
+
+	addErrors := func(operation string, errs map[string]error) bool {
+		for _, key := range sortedKeys(errs) {
+			diags.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to %s Santa rule %s, got error: %s", operation, key, errs[key]),
+			)
+		}
+		return len(errs) > 0
+	}
+
+	// deletes first, to leave the configuration with fewer rules in case of errors
+	errs := runSantaRulesBulkBatches(ctx, deletes, santaRulesBulkBatchSize, santaRulesBulkConcurrency, func(ctx context.Context, key string) error {
+		_, err := r.client.SantaRules.Delete(ctx, int(current[key].ID))
+		if err == nil {
+			mu.Lock()
+			delete(applied, key)
+			mu.Unlock()
+		}
+		return err
+	})
+	if addErrors("delete", errs) {
+		return applied, diags
+	}
+	tflog.Trace(ctx, "deleted Santa rules", map[string]interface{}{"count": len(deletes)})
+
+	errs = runSantaRulesBulkBatches(ctx, updates, santaRulesBulkBatchSize, santaRulesBulkConcurrency, func(ctx context.Context, key string) error {
+		id := current[key].ID
+		_, _, err := r.client.SantaRules.Update(ctx, int(id), santaRulesBulkRuleRequestWithState(configurationID, desired[key].Rule))
+		if err == nil {
+			mu.Lock()
+			applied[key] = santaRulesBulkEntry{ID: id, Rule: desired[key].Rule}
+			mu.Unlock()
+		}
+		return err
+	})
+	if addErrors("update", errs) {
+		return applied, diags
+	}
+	tflog.Trace(ctx, "updated Santa rules", map[string]interface{}{"count": len(updates)})
+
+	errs = runSantaRulesBulkBatches(ctx, creates, santaRulesBulkBatchSize, santaRulesBulkConcurrency, func(ctx context.Context, key string) error {
+		ztlSR, _, err := r.client.SantaRules.Create(ctx, santaRulesBulkRuleRequestWithState(configurationID, desired[key].Rule))
+		if err == nil {
+			mu.Lock()
+			applied[key] = santaRulesBulkEntry{ID: int64(ztlSR.ID), Rule: desired[key].Rule}
+			mu.Unlock()
+		}
+		return err
+	})
+	if addErrors("create", errs) {
+		return applied, diags
+	}
+	tflog.Trace(ctx, "created Santa rules", map[string]interface{}{"count": len(creates)})
+
+	return applied, diags
+}
+
+func (r *SantaRulesBulkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data santaRulesBulk
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	applied, diags := r.apply(ctx, data.ConfigurationID, map[string]santaRulesBulkEntry{}, santaRulesBulkEntriesWithState(data))
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Trace(ctx, "created the Santa rules of a configuration")
+
+	// Save data into Terraform state, including the rules created before an error
+	resp.Diagnostics.Append(resp.State.Set(ctx, santaRulesBulkForState(data.ConfigurationID, data.ConfigurationID, applied))...)
+}
+
+func (r *SantaRulesBulkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data santaRulesBulk
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ztlSRs, _, err := listSantaRules(ctx, r.client, url.Values{"configuration_id": {strconv.FormatInt(data.ConfigurationID.ValueInt64(), 10)}})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client error",
+			fmt.Sprintf("Unable to read the Santa rules of configuration %d, got error: %s", data.ConfigurationID.ValueInt64(), err),
+		)
+		return
+	}
+
+	ztlSRsByID := make(map[int64]*goztl.SantaRule)
+	for i := range ztlSRs {
+		ztlSR := &ztlSRs[i]
+		if int64(ztlSR.ConfigurationID) == data.ConfigurationID.ValueInt64() {
+			ztlSRsByID[int64(ztlSR.ID)] = ztlSR
+		}
+	}
+
+	entries := make(map[string]santaRulesBulkEntry)
+	if data.RuleIDs.IsNull() {
+		// imported with only the configuration ID, all the rules that are not
+		// managed by a Zentral Santa ruleset are adopted
+		for _, ztlSR := range ztlSRsByID {
+			if ztlSR.RulesetID == nil {
+				entries[santaRulesBulkRuleKey(ztlSR.TargetType, ztlSR.TargetIdentifier)] = santaRulesBulkEntry{ID: int64(ztlSR.ID), Rule: santaRulesBulkRuleForState(ztlSR)}
+			}
+		}
+	} else {
+		// only the rules with an ID in the state are managed
+		for key, elem := range data.RuleIDs.Elements() {
+			id := elem.(types.Int64).ValueInt64()
+			ztlSR, ok := ztlSRsByID[id]
+			if !ok {
+				tflog.Warn(ctx, "Santa rule not found, removing it from the managed rules", map[string]interface{}{"id": id, "key": key})
+				continue
+			}
+			entries[santaRulesBulkRuleKey(ztlSR.TargetType, ztlSR.TargetIdentifier)] = santaRulesBulkEntry{ID: id, Rule: santaRulesBulkRuleForState(ztlSR)}
+		}
+	}
+
+	tflog.Trace(ctx, "read the Santa rules of a configuration")
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, santaRulesBulkForState(data.ConfigurationID, data.ConfigurationID, entries))...)
+}
+
+func (r *SantaRulesBulkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state santaRulesBulk
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	applied, diags := r.apply(ctx, data.ConfigurationID, santaRulesBulkEntriesWithState(state), santaRulesBulkEntriesWithState(data))
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Trace(ctx, "updated the Santa rules of a configuration")
+
+	// Save updated data into Terraform state, including the rules applied before an error
+	resp.Diagnostics.Append(resp.State.Set(ctx, santaRulesBulkForState(data.ConfigurationID, data.ConfigurationID, applied))...)
+}
+
+func (r *SantaRulesBulkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data santaRulesBulk
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remaining, diags := r.apply(ctx, data.ConfigurationID, santaRulesBulkEntriesWithState(data), map[string]santaRulesBulkEntry{})
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		// Keep the rules that could not be deleted in the state
+		resp.Diagnostics.Append(resp.State.Set(ctx, santaRulesBulkForState(data.ID, data.ConfigurationID, remaining))...)
+		return
+	}
+
+	tflog.Trace(ctx, "deleted the Santa rules of a configuration")
+}
+
+func (r *SantaRulesBulkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	configurationID, ruleIDs, err := parseSantaRulesBulkImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid resource ID",
+			fmt.Sprintf("Zentral Santa rules bulk ID must be <configuration_id> or <configuration_id>/<rule_id>,<rule_id>,…: %s", err),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.Int64Value(configurationID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("configuration_id"), types.Int64Value(configurationID))...)
+	if ruleIDs == nil {
+		// null rule IDs, all the rules of the configuration are adopted by Read
+		return
+	}
+	// the rule keys are not known yet, and are set by Read
+	ruleIDsByKey := make(map[string]attr.Value, len(ruleIDs))
+	for _, ruleID := range ruleIDs {
+		ruleIDsByKey[strconv.FormatInt(ruleID, 10)] = types.Int64Value(ruleID)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("rule_ids"), types.MapValueMust(types.Int64Type, ruleIDsByKey))...)
+}
@@ -0,0 +1,256 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccSantaRulesBulkResource(t *testing.T) {
+	name := acctest.RandString(12)
+	tagName := acctest.RandString(12)
+	resourceName := "zentral_santa_rules_bulk.test"
+	cfgResourceName := "zentral_santa_configuration.test"
+	tagResourceName := "zentral_tag.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccSantaRulesBulkResourceConfigBare(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						resourceName, "id", cfgResourceName, "id"),
+					resource.TestCheckResourceAttrPair(
+						resourceName, "configuration_id", cfgResourceName, "id"),
+					resource.TestCheckResourceAttr(
+						resourceName, "rules.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						resourceName, "rules.*",
+						map[string]string{
+							"policy":            "ALLOWLIST",
+							"cel_expr":          "",
+							"target_type":       "CDHASH",
+							"target_identifier": "9f3e7b21a0a745297dd906dad4a4a4637bdec066",
+							"description":       "",
+							"custom_message":    "",
+							"custom_url":        "",
+							"tag_ids.#":         "0",
+						},
+					),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						resourceName, "rules.*",
+						map[string]string{
+							"policy":            "BLOCKLIST",
+							"target_type":       "TEAMID",
+							"target_identifier": "MLF9FE35AM",
+						},
+					),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						resourceName, "rules.*",
+						map[string]string{
+							"policy":            "ALLOWLIST",
+							"target_type":       "SIGNINGID",
+							"target_identifier": "EQHXZ8M8AV:com.google.Chrome",
+						},
+					),
+					resource.TestCheckResourceAttr(
+						resourceName, "rule_ids.%", "3"),
+					resource.TestCheckResourceAttrSet(
+						resourceName, "rule_ids.CDHASH/9f3e7b21a0a745297dd906dad4a4a4637bdec066"),
+					resource.TestCheckResourceAttrSet(
+						resourceName, "rule_ids.TEAMID/MLF9FE35AM"),
+					resource.TestCheckResourceAttrSet(
+						resourceName, "rule_ids.SIGNINGID/EQHXZ8M8AV:com.google.Chrome"),
+				),
+			},
+			// ImportState
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccSantaRulesBulkImportStateIDFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			// Update: one rule updated, one deleted, one created
+			{
+				Config: testAccSantaRulesBulkResourceConfigFull(name, tagName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						resourceName, "rules.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						resourceName, "rules.*",
+						map[string]string{
+							"policy":            "BLOCKLIST",
+							"target_type":       "CDHASH",
+							"target_identifier": "9f3e7b21a0a745297dd906dad4a4a4637bdec066",
+							"description":       "description",
+							"custom_message":    "custom message",
+							"custom_url":        "https://www.example.com",
+							"serial_numbers.#":  "1",
+							"tag_ids.#":         "1",
+						},
+					),
+					resource.TestCheckTypeSetElemAttrPair(
+						resourceName, "rules.*.tag_ids.*", tagResourceName, "id"),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						resourceName, "rules.*",
+						map[string]string{
+							"policy":            "BLOCKLIST",
+							"target_type":       "TEAMID",
+							"target_identifier": "MLF9FE35AM",
+						},
+					),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						resourceName, "rules.*",
+						map[string]string{
+							"policy":            "SILENT_BLOCKLIST",
+							"target_type":       "BINARY",
+							"target_identifier": "fc6a0f9b3f6d9d1cc1e6d5a7c1b1b1f21b2b8c0d5b5d1b0d0e0f9e8d7c6b5a49",
+						},
+					),
+					resource.TestCheckResourceAttr(
+						resourceName, "rule_ids.%", "3"),
+					resource.TestCheckNoResourceAttr(
+						resourceName, "rule_ids.SIGNINGID/EQHXZ8M8AV:com.google.Chrome"),
+					resource.TestCheckResourceAttrSet(
+						resourceName, "rule_ids.BINARY/fc6a0f9b3f6d9d1cc1e6d5a7c1b1b1f21b2b8c0d5b5d1b0d0e0f9e8d7c6b5a49"),
+				),
+			},
+			// ImportState, with the rule IDs
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccSantaRulesBulkImportStateIDFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			// ImportState, with all the rules of the configuration
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportStateIDFunc(resourceName, "configuration_id"),
+				ImportStateVerify: true,
+			},
+			// Rule deleted outside of Terraform
+			{
+				Config: testAccSantaRulesBulkResourceConfigFull(name, tagName),
+				Check: func(s *terraform.State) error {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return fmt.Errorf("resource %s not found in state", resourceName)
+					}
+					ztlID, err := strconv.Atoi(rs.Primary.Attributes["rule_ids.TEAMID/MLF9FE35AM"])
+					if err != nil {
+						return err
+					}
+					c, err := testAccClient()
+					if err != nil {
+						return err
+					}
+					_, err = c.SantaRules.Delete(context.Background(), ztlID)
+					return err
+				},
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+// testAccSantaRulesBulkImportStateIDFunc returns the
+// <configuration_id>/<rule_id>,<rule_id>,… import ID of the managed rules.
+func testAccSantaRulesBulkImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource %s not found in state", resourceName)
+		}
+		var ruleIDs []string
+		for attrName, value := range rs.Primary.Attributes {
+			if strings.HasPrefix(attrName, "rule_ids.") && attrName != "rule_ids.%" {
+				ruleIDs = append(ruleIDs, value)
+			}
+		}
+		return rs.Primary.Attributes["configuration_id"] + "/" + strings.Join(ruleIDs, ","), nil
+	}
+}
+
+func testAccSantaRulesBulkResourceConfigBare(name string) string {
+	return fmt.Sprintf(`
+resource "zentral_santa_configuration" "test" {
+  name = %[1]q
+}
+
+resource "zentral_santa_rules_bulk" "test" {
+  configuration_id = zentral_santa_configuration.test.id
+
+  rules = [
+    {
+      policy            = "ALLOWLIST"
+      target_type       = "CDHASH"
+      target_identifier = "9f3e7b21a0a745297dd906dad4a4a4637bdec066"
+    },
+    {
+      policy            = "BLOCKLIST"
+      target_type       = "TEAMID"
+      target_identifier = "MLF9FE35AM"
+    },
+    {
+      policy            = "ALLOWLIST"
+      target_type       = "SIGNINGID"
+      target_identifier = "EQHXZ8M8AV:com.google.Chrome"
+    },
+  ]
+}
+`, name)
+}
+
+func testAccSantaRulesBulkResourceConfigFull(name string, tagName string) string {
+	return fmt.Sprintf(`
+resource "zentral_santa_configuration" "test" {
+  name = %[1]q
+}
+
+resource "zentral_taxonomy" "test" {
+  name = %[1]q
+}
+
+resource "zentral_tag" "test" {
+  taxonomy_id = zentral_taxonomy.test.id
+  name        = %[2]q
+}
+
+resource "zentral_santa_rules_bulk" "test" {
+  configuration_id = zentral_santa_configuration.test.id
+
+  rules = [
+    {
+      policy            = "BLOCKLIST"
+      target_type       = "CDHASH"
+      target_identifier = "9f3e7b21a0a745297dd906dad4a4a4637bdec066"
+      description       = "description"
+      custom_message    = "custom message"
+      custom_url        = "https://www.example.com"
+      serial_numbers    = ["0123456789"]
+      tag_ids           = [zentral_tag.test.id]
+    },
+    {
+      policy            = "BLOCKLIST"
+      target_type       = "TEAMID"
+      target_identifier = "MLF9FE35AM"
+    },
+    {
+      policy            = "SILENT_BLOCKLIST"
+      target_type       = "BINARY"
+      target_identifier = "fc6a0f9b3f6d9d1cc1e6d5a7c1b1b1f21b2b8c0d5b5d1b0d0e0f9e8d7c6b5a49"
+    },
+  ]
+}
+`, name, tagName)
+}
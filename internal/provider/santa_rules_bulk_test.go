@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testSantaRulesBulkRule(targetType string, targetIdentifier string, policy string) types.Object {
+	emptyStrings := types.SetValueMust(types.StringType, []attr.Value{})
+	emptyInt64s := types.SetValueMust(types.Int64Type, []attr.Value{})
+	return types.ObjectValueMust(
+		santaRulesBulkRuleAttrTypes,
+		map[string]attr.Value{
+			"policy":                  types.StringValue(policy),
+			"cel_expr":                types.StringValue(""),
+			"target_type":             types.StringValue(targetType),
+			"target_identifier":       types.StringValue(targetIdentifier),
+			"description":             types.StringValue(""),
+			"custom_message":          types.StringValue(""),
+			"custom_url":              types.StringValue(""),
+			"primary_users":           emptyStrings,
+			"excluded_primary_users":  emptyStrings,
+			"serial_numbers":          emptyStrings,
+			"excluded_serial_numbers": emptyStrings,
+			"tag_ids":                 emptyInt64s,
+			"excluded_tag_ids":        emptyInt64s,
+		},
+	)
+}
+
+func TestSantaRulesBulkEntries(t *testing.T) {
+	entries := map[string]santaRulesBulkEntry{
+		"TEAMID/MLF9FE35AM":             {ID: 1, Rule: testSantaRulesBulkRule("TEAMID", "MLF9FE35AM", tfSantaAllowlist)},
+		"SIGNINGID/EQHXZ8M8AV:com.yolo": {ID: 2, Rule: testSantaRulesBulkRule("SIGNINGID", "EQHXZ8M8AV:com.yolo", tfSantaBlocklist)},
+	}
+	data := santaRulesBulkForState(types.Int64Value(3), types.Int64Value(3), entries)
+	if len(data.Rules.Elements()) != 2 || len(data.RuleIDs.Elements()) != 2 {
+		t.Fatalf("unexpected state %+v", data)
+	}
+	got := santaRulesBulkEntriesWithState(data)
+	if len(got) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(got))
+	}
+	for key, entry := range entries {
+		if got[key].ID != entry.ID || !got[key].Rule.Equal(entry.Rule) {
+			t.Errorf("%s: expected %+v, got %+v", key, entry, got[key])
+		}
+	}
+
+	// planned rules without IDs
+	data.RuleIDs = types.MapUnknown(types.Int64Type)
+	for key, entry := range santaRulesBulkEntriesWithState(data) {
+		if entry.ID != 0 {
+			t.Errorf("%s: unexpected ID %d", key, entry.ID)
+		}
+	}
+}
+
+func TestDiffSantaRulesBulk(t *testing.T) {
+	current := map[string]santaRulesBulkEntry{
+		"TEAMID/A": {ID: 1, Rule: testSantaRulesBulkRule("TEAMID", "A", tfSantaAllowlist)},
+		"TEAMID/B": {ID: 2, Rule: testSantaRulesBulkRule("TEAMID", "B", tfSantaAllowlist)},
+		"TEAMID/C": {ID: 3, Rule: testSantaRulesBulkRule("TEAMID", "C", tfSantaAllowlist)},
+	}
+	desired := map[string]santaRulesBulkEntry{
+		"TEAMID/A": {Rule: testSantaRulesBulkRule("TEAMID", "A", tfSantaAllowlist)},
+		"TEAMID/B": {Rule: testSantaRulesBulkRule("TEAMID", "B", tfSantaBlocklist)},
+		"TEAMID/D": {Rule: testSantaRulesBulkRule("TEAMID", "D", tfSantaAllowlist)},
+		"TEAMID/E": {Rule: testSantaRulesBulkRule("TEAMID", "E", tfSantaAllowlist)},
+	}
+	creates, updates, deletes := diffSantaRulesBulk(current, desired)
+	if !slices.Equal(creates, []string{"TEAMID/D", "TEAMID/E"}) {
+		t.Errorf("unexpected creates %q", creates)
+	}
+	if !slices.Equal(updates, []string{"TEAMID/B"}) {
+		t.Errorf("unexpected updates %q", updates)
+	}
+	if !slices.Equal(deletes, []string{"TEAMID/C"}) {
+		t.Errorf("unexpected deletes %q", deletes)
+	}
+}
+
+func TestRunSantaRulesBulkBatches(t *testing.T) {
+	var keys []string
+	for i := 0; i < 25; i++ {
+		keys = append(keys, fmt.Sprintf("TEAMID/%02d", i))
+	}
+
+	// all the keys, with at most 3 concurrent calls
+	var running, maxRunning int32
+	var mu sync.Mutex
+	var done []string
+	errs := runSantaRulesBulkBatches(context.Background(), keys, 10, 3, func(ctx context.Context, key string) error {
+		n := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		mu.Lock()
+		maxRunning = max(maxRunning, n)
+		done = append(done, key)
+		mu.Unlock()
+		return nil
+	})
+	if len(errs) != 0 {
+		t.Errorf("unexpected errors %v", errs)
+	}
+	slices.Sort(done)
+	if !slices.Equal(done, keys) {
+		t.Errorf("unexpected keys %q", done)
+	}
+	if maxRunning > 3 {
+		t.Errorf("unexpected concurrency %d", maxRunning)
+	}
+
+	// stop after the first batch with errors
+	var calls int32
+	errs = runSantaRulesBulkBatches(context.Background(), keys, 10, 3, func(ctx context.Context, key string) error {
+		atomic.AddInt32(&calls, 1)
+		if key == "TEAMID/12" {
+			return errors.New("yolo")
+		}
+		return nil
+	})
+	if len(errs) != 1 || errs["TEAMID/12"] == nil {
+		t.Errorf("unexpected errors %v", errs)
+	}
+	if calls != 20 {
+		t.Errorf("expected 20 calls, got %d", calls)
+	}
+
+	// canceled context
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	errs = runSantaRulesBulkBatches(ctx, keys, 10, 3, func(ctx context.Context, key string) error {
+		t.Errorf("unexpected call for %s", key)
+		return nil
+	})
+	if len(errs) != len(keys) {
+		t.Errorf("expected %d errors, got %d", len(keys), len(errs))
+	}
+}
+
+func TestParseSantaRulesBulkImportID(t *testing.T) {
+	cases := []struct {
+		id              string
+		configurationID int64
+		ruleIDs         []int64
+		err             string
+	}{
+		{"3", 3, nil, ""},
+		{"3/1", 3, []int64{1}, ""},
+		{"3/1, 2,17", 3, []int64{1, 2, 17}, ""},
+		{"yolo", 0, nil, "configuration_id must be an integer"},
+		{"3/", 0, nil, "rule IDs must be integers"},
+		{"3/1,yolo", 0, nil, "rule IDs must be integers"},
+	}
+	for _, c := range cases {
+		configurationID, ruleIDs, err := parseSantaRulesBulkImportID(c.id)
+		if c.err != "" {
+			if err == nil || err.Error() != c.err {
+				t.Errorf("%q: expected error %q, got %v", c.id, c.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: unexpected error %s", c.id, err)
+		} else if configurationID != c.configurationID || !slices.Equal(ruleIDs, c.ruleIDs) {
+			t.Errorf("%q: expected %d %v, got %d %v", c.id, c.configurationID, c.ruleIDs, configurationID, ruleIDs)
+		}
+	}
+}
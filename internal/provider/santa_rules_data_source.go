@@ -0,0 +1,253 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zentralopensource/goztl"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &SantaRulesDataSource{}
+
+func NewSantaRulesDataSource() datasource.DataSource {
+	return &SantaRulesDataSource{}
+}
+
+// SantaRulesDataSource defines the data source implementation.
+type SantaRulesDataSource struct {
+	client *goztl.Client
+}
+
+type santaRules struct {
+	ConfigurationID  types.Int64  `tfsdk:"configuration_id"`
+	Policy           types.String `tfsdk:"policy"`
+	TargetType       types.String `tfsdk:"target_type"`
+	TargetIdentifier types.String `tfsdk:"target_identifier"`
+	TagID            types.Int64  `tfsdk:"tag_id"`
+	RulesetManaged   types.Bool   `tfsdk:"ruleset_managed"`
+	Rules            types.List   `tfsdk:"rules"`
+}
+
+func (d *SantaRulesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_santa_rules"
+}
+
+func (d *SantaRulesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Allows details of the Santa rules to be retrieved, optionally filtered by configuration, policy, target, tag or ruleset management.",
+		MarkdownDescription: "The data source `zentral_santa_rules` allows details of the Santa rules to be retrieved, optionally filtered by configuration, policy, target, tag or ruleset management.",
+
+		Attributes: map[string]schema.Attribute{
+			"configuration_id": schema.Int64Attribute{
+				Description:         "Only return the rules of the Santa configuration with this ID.",
+				MarkdownDescription: "Only return the rules of the Santa configuration with this `ID`.",
+				Optional:            true,
+			},
+			"policy": schema.StringAttribute{
+				Description:         "Only return the rules with this policy. Valid values are ALLOWLIST, ALLOWLIST_COMPILER, BLOCKLIST, CEL, and SILENT_BLOCKLIST.",
+				MarkdownDescription: "Only return the rules with this policy. Valid values are `ALLOWLIST`, `ALLOWLIST_COMPILER`, `BLOCKLIST`, `CEL`, and `SILENT_BLOCKLIST`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{tfSantaAllowlist, tfSantaAllowlistCompiler, tfSantaCEL, tfSantaBlocklist, tfSantaSilentBlocklist}...),
+				},
+			},
+			"target_type": schema.StringAttribute{
+				Description:         "Only return the rules with this target type. Valid values are BINARY, CDHASH, CERTIFICATE, SIGNINGID and TEAMID.",
+				MarkdownDescription: "Only return the rules with this target type. Valid values are `BINARY`, `CDHASH`, `CERTIFICATE`, `SIGNINGID` and `TEAMID`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"BINARY", "CDHASH", "CERTIFICATE", "SIGNINGID", "TEAMID"}...),
+				},
+			},
+			"target_identifier": schema.StringAttribute{
+				Description:         "Only return the rules with this target identifier.",
+				MarkdownDescription: "Only return the rules with this target identifier.",
+				Optional:            true,
+			},
+			"tag_id": schema.Int64Attribute{
+				Description:         "Only return the rules scoped to the tag with this ID.",
+				MarkdownDescription: "Only return the rules scoped to the tag with this `ID`.",
+				Optional:            true,
+			},
+			"ruleset_managed": schema.BoolAttribute{
+				Description:         "If true, only return the rules managed by a Zentral Santa ruleset, with a ruleset_id. If false, only return the rules without a ruleset_id.",
+				MarkdownDescription: "If `true`, only return the rules managed by a Zentral Santa ruleset, with a `ruleset_id`. If `false`, only return the rules without a `ruleset_id`.",
+				Optional:            true,
+			},
+			"rules": schema.ListNestedAttribute{
+				Description:         "List of the Santa rules, sorted by ID.",
+				MarkdownDescription: "List of the Santa rules, sorted by `ID`.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description:         "ID of the Santa rule.",
+							MarkdownDescription: "`ID` of the Santa rule.",
+							Computed:            true,
+						},
+						"configuration_id": schema.Int64Attribute{
+							Description:         "ID of the Santa configuration.",
+							MarkdownDescription: "`ID` of the Santa configuration.",
+							Computed:            true,
+						},
+						"policy": schema.StringAttribute{
+							Description:         "Policy. Valid values are ALLOWLIST, ALLOWLIST_COMPILER, BLOCKLIST, CEL, and SILENT_BLOCKLIST.",
+							MarkdownDescription: "Policy. Valid values are `ALLOWLIST`, `ALLOWLIST_COMPILER`, `BLOCKLIST`, `CEL`, and `SILENT_BLOCKLIST`.",
+							Computed:            true,
+						},
+						"cel_expr": schema.StringAttribute{
+							Description:         "CEL expression.",
+							MarkdownDescription: "CEL expression.",
+							Computed:            true,
+						},
+						"target_type": schema.StringAttribute{
+							Description:         "Target type. Valid values are BINARY, CDHASH, CERTIFICATE, SIGNINGID and TEAMID.",
+							MarkdownDescription: "Target type. Valid values are `BINARY`, `CDHASH`, `CERTIFICATE`, `SIGNINGID` and `TEAMID`.",
+							Computed:            true,
+						},
+						"target_identifier": schema.StringAttribute{
+							Description:         "Target identifier: binary or certificate sha256, CD hash, signing ID or team ID.",
+							MarkdownDescription: "Target identifier: binary or certificate sha256, CD hash, signing ID or team ID.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							Description:         "Description of the rule. Only displayed in the Zentral GUI.",
+							MarkdownDescription: "Description of the rule. Only displayed in the Zentral GUI.",
+							Computed:            true,
+						},
+						"custom_message": schema.StringAttribute{
+							Description:         "Custom message displayed in the popover when a binary is blocked.",
+							MarkdownDescription: "Custom message displayed in the popover when a binary is blocked.",
+							Computed:            true,
+						},
+						"custom_url": schema.StringAttribute{
+							Description:         "Custom URL the user can visit for more information when blocked.",
+							MarkdownDescription: "Custom URL the user can visit for more information when blocked.",
+							Computed:            true,
+						},
+						"ruleset_id": schema.Int64Attribute{
+							Description:         "ID of the Santa ruleset. Null if the rule is not managed by a ruleset.",
+							MarkdownDescription: "`ID` of the Santa ruleset. Null if the rule is not managed by a ruleset.",
+							Computed:            true,
+						},
+						"primary_users": schema.SetAttribute{
+							Description:         "The primary users used to scope the rule.",
+							MarkdownDescription: "The primary users used to scope the rule.",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"excluded_primary_users": schema.SetAttribute{
+							Description:         "The excluded primary users used to scope the rule.",
+							MarkdownDescription: "The excluded primary users used to scope the rule.",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"serial_numbers": schema.SetAttribute{
+							Description:         "The serial numbers used to scope the rule.",
+							MarkdownDescription: "The serial numbers used to scope the rule.",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"excluded_serial_numbers": schema.SetAttribute{
+							Description:         "The excluded serial numbers used to scope the rule.",
+							MarkdownDescription: "The excluded serial numbers used to scope the rule.",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"tag_ids": schema.SetAttribute{
+							Description:         "The IDs of the tags used to scope the rule.",
+							MarkdownDescription: "The `ID`s of the tags used to scope the rule.",
+							ElementType:         types.Int64Type,
+							Computed:            true,
+						},
+						"excluded_tag_ids": schema.SetAttribute{
+							Description:         "The IDs of the excluded tags used to scope the rule.",
+							MarkdownDescription: "The `ID`s of the excluded tags used to scope the rule.",
+							ElementType:         types.Int64Type,
+							Computed:            true,
+						},
+						"version": schema.Int64Attribute{
+							Description:         "Rule version.",
+							MarkdownDescription: "Rule version.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SantaRulesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*goztl.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *goztl.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SantaRulesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data santaRules
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filters := santaRuleFilters{
+		ConfigurationID:  data.ConfigurationID,
+		Policy:           data.Policy,
+		TargetType:       data.TargetType,
+		TargetIdentifier: data.TargetIdentifier,
+		TagID:            data.TagID,
+		RulesetManaged:   data.RulesetManaged,
+	}
+
+	ztlSRs, _, err := listSantaRules(ctx, d.client, filters.query())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to list Santa rules, got error: %s", err),
+		)
+		return
+	}
+	sort.Slice(ztlSRs, func(i, j int) bool { return ztlSRs[i].ID < ztlSRs[j].ID })
+
+	srsForState := make([]santaRule, 0)
+	for i := range ztlSRs {
+		sr := santaRuleForState(&ztlSRs[i])
+		if filters.match(sr) {
+			srsForState = append(srsForState, sr)
+		}
+	}
+
+	rules, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: santaRuleAttrTypes}, srsForState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Rules = rules
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
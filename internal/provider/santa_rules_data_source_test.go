@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSantaRulesDataSource(t *testing.T) {
+	name := acctest.RandString(12)
+	tagName := acctest.RandString(12)
+	r1ResourceName := "zentral_santa_rule.test1"
+	r2ResourceName := "zentral_santa_rule.test2"
+	cfgResourceName := "zentral_santa_configuration.test"
+	tagResourceName := "zentral_tag.test"
+	dsAllResourceName := "data.zentral_santa_rules.all"
+	dsPolicyResourceName := "data.zentral_santa_rules.by_policy"
+	dsTargetResourceName := "data.zentral_santa_rules.by_target"
+	dsTagResourceName := "data.zentral_santa_rules.by_tag"
+	dsManagedResourceName := "data.zentral_santa_rules.managed"
+	dsUnmanagedResourceName := "data.zentral_santa_rules.unmanaged"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSantaRulesDataSourceConfig(name, tagName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Filtered by configuration
+					resource.TestCheckResourceAttr(
+						dsAllResourceName, "rules.#", "3"),
+					// Filtered by configuration and policy
+					resource.TestCheckResourceAttr(
+						dsPolicyResourceName, "rules.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						dsPolicyResourceName, "rules.0.id", r1ResourceName, "id"),
+					resource.TestCheckResourceAttrPair(
+						dsPolicyResourceName, "rules.0.configuration_id", cfgResourceName, "id"),
+					resource.TestCheckResourceAttr(
+						dsPolicyResourceName, "rules.0.policy", "BLOCKLIST"),
+					resource.TestCheckResourceAttr(
+						dsPolicyResourceName, "rules.0.target_type", "TEAMID"),
+					resource.TestCheckResourceAttr(
+						dsPolicyResourceName, "rules.0.target_identifier", "MLF9FE35AM"),
+					resource.TestCheckResourceAttr(
+						dsPolicyResourceName, "rules.0.custom_message", "custom message"),
+					resource.TestCheckNoResourceAttr(
+						dsPolicyResourceName, "rules.0.ruleset_id"),
+					resource.TestCheckResourceAttr(
+						dsPolicyResourceName, "rules.0.tag_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(
+						dsPolicyResourceName, "rules.0.tag_ids.*", tagResourceName, "id"),
+					resource.TestCheckResourceAttr(
+						dsPolicyResourceName, "rules.0.version", "1"),
+					// Filtered by target
+					resource.TestCheckResourceAttr(
+						dsTargetResourceName, "rules.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						dsTargetResourceName, "rules.0.id", r2ResourceName, "id"),
+					// Filtered by tag
+					resource.TestCheckResourceAttr(
+						dsTagResourceName, "rules.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						dsTagResourceName, "rules.0.id", r1ResourceName, "id"),
+					// Filtered by ruleset management, the rules of the
+					// zentral_santa_rules_bulk resource are not part of a ruleset
+					resource.TestCheckResourceAttr(
+						dsManagedResourceName, "rules.#", "0"),
+					resource.TestCheckResourceAttr(
+						dsUnmanagedResourceName, "rules.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSantaRulesDataSourceConfig(name string, tagName string) string {
+	return fmt.Sprintf(`
+resource "zentral_santa_configuration" "test" {
+  name = %[1]q
+}
+
+resource "zentral_taxonomy" "test" {
+  name = %[1]q
+}
+
+resource "zentral_tag" "test" {
+  taxonomy_id = zentral_taxonomy.test.id
+  name        = %[2]q
+}
+
+resource "zentral_santa_rule" "test1" {
+  configuration_id  = zentral_santa_configuration.test.id
+  policy            = "BLOCKLIST"
+  target_type       = "TEAMID"
+  target_identifier = "MLF9FE35AM"
+  custom_message    = "custom message"
+  tag_ids           = [zentral_tag.test.id]
+}
+
+resource "zentral_santa_rule" "test2" {
+  configuration_id  = zentral_santa_configuration.test.id
+  policy            = "ALLOWLIST"
+  target_type       = "CDHASH"
+  target_identifier = "9f3e7b21a0a745297dd906dad4a4a4637bdec066"
+}
+
+resource "zentral_santa_rules_bulk" "test" {
+  configuration_id = zentral_santa_configuration.test.id
+
+  rules = [
+    {
+      policy            = "SILENT_BLOCKLIST"
+      target_type       = "BINARY"
+      target_identifier = "fc6a0f9b3f6d9d1cc1e6d5a7c1b1b1f21b2b8c0d5b5d1b0d0e0f9e8d7c6b5a49"
+    },
+  ]
+}
+
+data "zentral_santa_rules" "all" {
+  configuration_id = zentral_santa_configuration.test.id
+
+  depends_on = [zentral_santa_rule.test1, zentral_santa_rule.test2, zentral_santa_rules_bulk.test]
+}
+
+data "zentral_santa_rules" "by_policy" {
+  configuration_id = zentral_santa_configuration.test.id
+  policy           = "BLOCKLIST"
+
+  depends_on = [zentral_santa_rule.test1, zentral_santa_rule.test2, zentral_santa_rules_bulk.test]
+}
+
+data "zentral_santa_rules" "by_target" {
+  configuration_id  = zentral_santa_configuration.test.id
+  target_type       = "CDHASH"
+  target_identifier = "9f3e7b21a0a745297dd906dad4a4a4637bdec066"
+
+  depends_on = [zentral_santa_rule.test1, zentral_santa_rule.test2, zentral_santa_rules_bulk.test]
+}
+
+data "zentral_santa_rules" "by_tag" {
+  tag_id = zentral_tag.test.id
+
+  depends_on = [zentral_santa_rule.test1, zentral_santa_rule.test2, zentral_santa_rules_bulk.test]
+}
+
+data "zentral_santa_rules" "managed" {
+  configuration_id = zentral_santa_configuration.test.id
+  ruleset_managed  = true
+
+  depends_on = [zentral_santa_rule.test1, zentral_santa_rule.test2, zentral_santa_rules_bulk.test]
+}
+
+data "zentral_santa_rules" "unmanaged" {
+  configuration_id = zentral_santa_configuration.test.id
+  ruleset_managed  = false
+
+  depends_on = [zentral_santa_rule.test1, zentral_santa_rule.test2, zentral_santa_rules_bulk.test]
+}
+`, name, tagName)
+}
@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	tfSantaRulesFormatMoroz    = "moroz"
+	tfSantaRulesFormatSantactl = "santactl"
+	tfSantaRulesFormatSync     = "sync"
+)
+
+// santaRulesFromPolicies maps the policies of the exports to the
+// zentral_santa_rule policies, including the legacy Santa policy names.
+var santaRulesFromPolicies = map[string]string{
+	tfSantaAllowlist:         tfSantaAllowlist,
+	tfSantaAllowlistCompiler: tfSantaAllowlistCompiler,
+	tfSantaBlocklist:         tfSantaBlocklist,
+	tfSantaSilentBlocklist:   tfSantaSilentBlocklist,
+	tfSantaCEL:               tfSantaCEL,
+	"WHITELIST":              tfSantaAllowlist,
+	"WHITELIST_COMPILER":     tfSantaAllowlistCompiler,
+	"BLACKLIST":              tfSantaBlocklist,
+	"SILENT_BLACKLIST":       tfSantaSilentBlocklist,
+}
+
+// Santa rule policy used in the sync protocol to remove a rule.
+const santaRulesFromRemovePolicy = "REMOVE"
+
+var santaRulesFromTargetTypes = []string{"BINARY", "CDHASH", "CERTIFICATE", "SIGNINGID", "TEAMID"}
+
+// santaExportedRule is a rule in a santactl export, a Moroz configuration, or
+// a Santa sync rule download response. The sha256 key was used instead of
+// identifier in older versions.
+type santaExportedRule struct {
+	Identifier string `json:"identifier" toml:"identifier"`
+	SHA256     string `json:"sha256" toml:"sha256"`
+	Policy     string `json:"policy" toml:"policy"`
+	RuleType   string `json:"rule_type" toml:"rule_type"`
+	CELExpr    string `json:"cel_expr" toml:"cel_expr"`
+	CustomMsg  string `json:"custom_msg" toml:"custom_msg"`
+	CustomURL  string `json:"custom_url" toml:"custom_url"`
+	Comment    string `json:"comment" toml:"comment"`
+}
+
+type santaExportedRules struct {
+	Rules []santaExportedRule `json:"rules" toml:"rules"`
+}
+
+// santaImportedRule is a rule with the zentral_santa_rule attributes.
+type santaImportedRule struct {
+	Policy           string `tfsdk:"policy"`
+	CELExpr          string `tfsdk:"cel_expr"`
+	TargetType       string `tfsdk:"target_type"`
+	TargetIdentifier string `tfsdk:"target_identifier"`
+	Description      string `tfsdk:"description"`
+	CustomMessage    string `tfsdk:"custom_message"`
+	CustomURL        string `tfsdk:"custom_url"`
+}
+
+var santaImportedRuleAttrTypes = map[string]attr.Type{
+	"policy":            types.StringType,
+	"cel_expr":          types.StringType,
+	"target_type":       types.StringType,
+	"target_identifier": types.StringType,
+	"description":       types.StringType,
+	"custom_message":    types.StringType,
+	"custom_url":        types.StringType,
+}
+
+// parseSantaRules parses the rules of a santactl export, a Moroz
+// configuration, or a Santa sync rule download response. The JSON formats can
+// be an object with a rules key, or a list of rules. The rules with the REMOVE
+// policy are skipped.
+func parseSantaRules(format string, content []byte) ([]santaImportedRule, error) {
+	var exported santaExportedRules
+	switch format {
+	case tfSantaRulesFormatMoroz:
+		if _, err := toml.Decode(string(content), &exported); err != nil {
+			return nil, err
+		}
+	case tfSantaRulesFormatSantactl, tfSantaRulesFormatSync:
+		if trimmed := bytes.TrimSpace(content); len(trimmed) > 0 && trimmed[0] == '[' {
+			if err := json.Unmarshal(trimmed, &exported.Rules); err != nil {
+				return nil, err
+			}
+		} else if err := json.Unmarshal(content, &exported); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unknown format %q", format)
+	}
+
+	rules := make([]santaImportedRule, 0, len(exported.Rules))
+	for i, er := range exported.Rules {
+		if er.Policy == santaRulesFromRemovePolicy {
+			continue
+		}
+		policy, ok := santaRulesFromPolicies[er.Policy]
+		if !ok {
+			return nil, fmt.Errorf("rule %d: unknown policy %q", i+1, er.Policy)
+		}
+		if !slices.Contains(santaRulesFromTargetTypes, er.RuleType) {
+			return nil, fmt.Errorf("rule %d: unknown rule type %q", i+1, er.RuleType)
+		}
+		identifier := er.Identifier
+		if identifier == "" {
+			identifier = er.SHA256
+		}
+		if identifier == "" {
+			return nil, fmt.Errorf("rule %d: missing identifier", i+1)
+		}
+		switch er.RuleType {
+		case "BINARY", "CDHASH", "CERTIFICATE":
+			// hex identifiers, lowercase in Zentral
+			identifier = strings.ToLower(identifier)
+		}
+		if problem := validateSantaRuleTargetIdentifier(er.RuleType, identifier); problem != "" {
+			return nil, fmt.Errorf("rule %d: %s", i+1, problem)
+		}
+		rules = append(rules, santaImportedRule{
+			Policy:           policy,
+			CELExpr:          er.CELExpr,
+			TargetType:       er.RuleType,
+			TargetIdentifier: identifier,
+			Description:      er.Comment,
+			CustomMessage:    er.CustomMsg,
+			CustomURL:        er.CustomURL,
+		})
+	}
+	return rules, nil
+}
@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ function.Function = &SantaRulesFromFunction{}
+
+func NewSantaRulesFromFunction() function.Function {
+	return &SantaRulesFromFunction{}
+}
+
+// SantaRulesFromFunction defines the function implementation.
+type SantaRulesFromFunction struct{}
+
+func (f *SantaRulesFromFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "santa_rules_from"
+}
+
+func (f *SantaRulesFromFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Parse Santa rules from an export",
+		Description:         "Returns the rules of a santactl rule export, a Moroz configuration, or a Santa sync rule download response, as a list of objects with the zentral_santa_rule attributes. Legacy policy names are converted, and rules with the REMOVE policy are skipped.",
+		MarkdownDescription: "Returns the rules of a `santactl rule --export` file, a Moroz configuration, or a Santa sync rule download response, as a list of objects with the `zentral_santa_rule` attributes: `policy`, `cel_expr`, `target_type`, `target_identifier`, `description`, `custom_message` and `custom_url`. Legacy policy names are converted, and rules with the `REMOVE` policy are skipped.",
+
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "format",
+				Description:         "Format of the content. Valid values are santactl, moroz and sync.",
+				MarkdownDescription: "Format of the content. Valid values are `santactl`, `moroz` and `sync`.",
+				Validators: []function.StringParameterValidator{
+					stringvalidator.OneOf([]string{tfSantaRulesFormatSantactl, tfSantaRulesFormatMoroz, tfSantaRulesFormatSync}...),
+				},
+			},
+			function.StringParameter{
+				Name:                "content",
+				Description:         "Content of the santactl JSON export, Moroz TOML configuration, or Santa sync JSON response.",
+				MarkdownDescription: "Content of the santactl `JSON` export, Moroz `TOML` configuration, or Santa sync `JSON` response.",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.ObjectType{AttrTypes: santaImportedRuleAttrTypes},
+		},
+	}
+}
+
+func (f *SantaRulesFromFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var format, content string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &format, &content))
+	if resp.Error != nil {
+		return
+	}
+
+	rules, err := parseSantaRules(format, []byte(content))
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Unable to parse the %s content: %s", format, err))
+		return
+	}
+
+	result, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: santaImportedRuleAttrTypes}, rules)
+	resp.Error = function.FuncErrorFromDiags(ctx, diags)
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
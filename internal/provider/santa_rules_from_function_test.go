@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestSantaRulesFromFunctionRun(t *testing.T) {
+	ctx := context.Background()
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{
+			types.StringValue("moroz"),
+			types.StringValue(testSantaRulesFromMorozConfig),
+		}),
+	}
+	resp := &function.RunResponse{Result: function.NewResultData(types.ListUnknown(types.ObjectType{AttrTypes: santaImportedRuleAttrTypes}))}
+	NewSantaRulesFromFunction().Run(ctx, req, resp)
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+	rules, ok := resp.Result.Value().(types.List)
+	if !ok {
+		t.Fatalf("unexpected result %s", resp.Result.Value())
+	}
+	if len(rules.Elements()) != 3 {
+		t.Fatalf("expected 3 rules, got %d", len(rules.Elements()))
+	}
+	expected := types.ObjectValueMust(
+		santaImportedRuleAttrTypes,
+		map[string]attr.Value{
+			"policy":            types.StringValue("BLOCKLIST"),
+			"cel_expr":          types.StringValue(""),
+			"target_type":       types.StringValue("BINARY"),
+			"target_identifier": types.StringValue("2dc104631939b4bdf5d6bccab76e166e37fe5e1605340cf68dab919df58b8eda"),
+			"description":       types.StringValue(""),
+			"custom_message":    types.StringValue("blocklist firefox"),
+			"custom_url":        types.StringValue(""),
+		},
+	)
+	if got := rules.Elements()[0]; !got.Equal(expected) {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+}
+
+func TestAccSantaRulesFromFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSantaRulesFromFunctionConfig("moroz", "[[rules]]\npolicy = \"FORBID\"\n"),
+				ExpectError: regexp.MustCompile(`rule 1: unknown policy "FORBID"`),
+			},
+			{
+				Config: testAccSantaRulesFromFunctionConfig("santactl", testSantaRulesFromSantactlExport),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("count", "2"),
+					resource.TestCheckOutput("first_policy", "CEL"),
+					resource.TestCheckOutput("first_description", "recent curl"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSantaRulesFromFunctionConfig(format string, content string) string {
+	return fmt.Sprintf(`
+locals {
+  rules = provider::zentral::santa_rules_from(%q, %q)
+}
+
+output "count" {
+  value = length(local.rules)
+}
+
+output "first_policy" {
+  value = try(local.rules[0].policy, "")
+}
+
+output "first_description" {
+  value = try(local.rules[0].description, "")
+}
+`, format, content)
+}
@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testSantaRulesFromMorozConfig = `
+client_mode = "LOCKDOWN"
+batch_size = 100
+
+[[rules]]
+rule_type = "BINARY"
+policy = "BLOCKLIST"
+identifier = "2dc104631939b4bdf5d6bccab76e166e37fe5e1605340cf68dab919df58b8eda"
+custom_msg = "blocklist firefox"
+
+[[rules]]
+rule_type = "CERTIFICATE"
+policy = "WHITELIST"
+sha256 = "e7726cf87cba9e25139465df5bd1557c8a8feed5c7dd338342d8da0959b63c8d"
+
+[[rules]]
+rule_type = "TEAMID"
+policy = "ALLOWLIST"
+identifier = "EQHXZ8M8AV"
+`
+
+const testSantaRulesFromSantactlExport = `{
+  "rules": [
+    {
+      "identifier": "platform:com.apple.curl",
+      "policy": "CEL",
+      "rule_type": "SIGNINGID",
+      "cel_expr": "target.signing_time >= timestamp('2025-05-31T00:00:00Z')",
+      "comment": "recent curl"
+    },
+    {
+      "identifier": "9f3e7b21a0a745297dd906dad4a4a4637bdec066",
+      "policy": "SILENT_BLOCKLIST",
+      "rule_type": "CDHASH",
+      "custom_url": "https://zentral.com"
+    }
+  ]
+}`
+
+const testSantaRulesFromSyncResponse = `[
+  {"identifier": "MLF9FE35AM", "policy": "REMOVE", "rule_type": "TEAMID"},
+  {"identifier": "9F3E7B21A0A745297DD906DAD4A4A4637BDEC066", "policy": "BLOCKLIST", "rule_type": "CDHASH"},
+  {"identifier": "EQHXZ8M8AV:com.google.Chrome", "policy": "ALLOWLIST_COMPILER", "rule_type": "SIGNINGID", "file_bundle_hash": "yolo"}
+]`
+
+func TestParseSantaRules(t *testing.T) {
+	cases := []struct {
+		name     string
+		format   string
+		content  string
+		expected []santaImportedRule
+	}{
+		{
+			"moroz",
+			"moroz",
+			testSantaRulesFromMorozConfig,
+			[]santaImportedRule{
+				{
+					Policy:           "BLOCKLIST",
+					TargetType:       "BINARY",
+					TargetIdentifier: "2dc104631939b4bdf5d6bccab76e166e37fe5e1605340cf68dab919df58b8eda",
+					CustomMessage:    "blocklist firefox",
+				},
+				{
+					Policy:           "ALLOWLIST",
+					TargetType:       "CERTIFICATE",
+					TargetIdentifier: "e7726cf87cba9e25139465df5bd1557c8a8feed5c7dd338342d8da0959b63c8d",
+				},
+				{
+					Policy:           "ALLOWLIST",
+					TargetType:       "TEAMID",
+					TargetIdentifier: "EQHXZ8M8AV",
+				},
+			},
+		},
+		{
+			"santactl",
+			"santactl",
+			testSantaRulesFromSantactlExport,
+			[]santaImportedRule{
+				{
+					Policy:           "CEL",
+					CELExpr:          "target.signing_time >= timestamp('2025-05-31T00:00:00Z')",
+					TargetType:       "SIGNINGID",
+					TargetIdentifier: "platform:com.apple.curl",
+					Description:      "recent curl",
+				},
+				{
+					Policy:           "SILENT_BLOCKLIST",
+					TargetType:       "CDHASH",
+					TargetIdentifier: "9f3e7b21a0a745297dd906dad4a4a4637bdec066",
+					CustomURL:        "https://zentral.com",
+				},
+			},
+		},
+		{
+			"sync",
+			"sync",
+			testSantaRulesFromSyncResponse,
+			[]santaImportedRule{
+				{
+					Policy:           "BLOCKLIST",
+					TargetType:       "CDHASH",
+					TargetIdentifier: "9f3e7b21a0a745297dd906dad4a4a4637bdec066",
+				},
+				{
+					Policy:           "ALLOWLIST_COMPILER",
+					TargetType:       "SIGNINGID",
+					TargetIdentifier: "EQHXZ8M8AV:com.google.Chrome",
+				},
+			},
+		},
+		{"empty", "sync", `{"rules": []}`, []santaImportedRule{}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			rules, err := parseSantaRules(c.format, []byte(c.content))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(rules, c.expected) {
+				t.Errorf("expected %+v, got %+v", c.expected, rules)
+			}
+		})
+	}
+}
+
+func TestParseSantaRulesErrors(t *testing.T) {
+	cases := []struct {
+		format  string
+		content string
+		err     string
+	}{
+		{"yolo", "", `unknown format "yolo"`},
+		{"moroz", "[[rules]\n", "toml: "},
+		{"santactl", `{"rules": {}}`, "json: "},
+		{"sync", `[{"identifier": "EQHXZ8M8AV", "policy": "FORBID", "rule_type": "TEAMID"}]`, `rule 1: unknown policy "FORBID"`},
+		{"sync", `[{"identifier": "EQHXZ8M8AV", "policy": "BLOCKLIST", "rule_type": "TEAM"}]`, `rule 1: unknown rule type "TEAM"`},
+		{"sync", `[{"policy": "REMOVE"}, {"policy": "BLOCKLIST", "rule_type": "BINARY"}]`, "rule 2: missing identifier"},
+		{"sync", `[{"identifier": "EQHXZ8M8AV", "policy": "BLOCKLIST", "rule_type": "SIGNINGID"}]`, "rule 1: A SIGNINGID target identifier must be"},
+	}
+	for _, c := range cases {
+		_, err := parseSantaRules(c.format, []byte(c.content))
+		if err == nil {
+			t.Errorf("%s %q: expected error %q, got none", c.format, c.content, c.err)
+		} else if !strings.HasPrefix(err.Error(), c.err) {
+			t.Errorf("%s %q: expected error %q, got %q", c.format, c.content, c.err, err)
+		}
+	}
+}
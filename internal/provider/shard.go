@@ -0,0 +1,16 @@
+package provider
+
+import (
+	"crypto/md5"
+	"encoding/binary"
+)
+
+// zentralShard returns the shard of a device, between 0 and modulo - 1, like
+// Zentral computes it: the first 4 bytes of the MD5 hash of the key followed
+// by the serial number, as a big-endian integer, modulo the shard modulo.
+// The key identifies the sharded object: the artifact ID for a blueprint
+// artifact, or the artifact version ID.
+func zentralShard(serialNumber string, key string, modulo int64) int64 {
+	h := md5.Sum([]byte(key + serialNumber))
+	return int64(binary.BigEndian.Uint32(h[:4])) % modulo
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ function.Function = &ShardFunction{}
+
+func NewShardFunction() function.Function {
+	return &ShardFunction{}
+}
+
+// ShardFunction defines the function implementation.
+type ShardFunction struct{}
+
+func (f *ShardFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "shard"
+}
+
+func (f *ShardFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Compute the shard of a device",
+		Description:         "Returns the shard of a device for a key and a shard modulo, computed like in Zentral for the MDM blueprint artifacts and artifact versions. A device is in scope if its shard is strictly below the default shard, or the tag shard of one of its tags. The osquery pack shard is computed by osquery, not by Zentral, and cannot be predicted with this function.",
+		MarkdownDescription: "Returns the shard of a device for a key and a shard modulo, computed like in Zentral for the MDM blueprint artifacts and artifact versions. A device is in scope if its shard is strictly below the default shard, or the tag shard of one of its tags. The osquery pack shard is computed by osquery, not by Zentral, and cannot be predicted with this function.",
+
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "serial_number",
+				Description:         "Serial number of the device.",
+				MarkdownDescription: "Serial number of the device.",
+			},
+			function.StringParameter{
+				Name:                "key",
+				Description:         "Key of the sharded object: the artifact ID for a blueprint artifact, or the artifact version ID.",
+				MarkdownDescription: "Key of the sharded object: the artifact `ID` for a blueprint artifact, or the artifact version `ID`.",
+			},
+			function.Int64Parameter{
+				Name:                "modulo",
+				Description:         "Shard modulo.",
+				MarkdownDescription: "Shard modulo.",
+				Validators: []function.Int64ParameterValidator{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f *ShardFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var serialNumber, key string
+	var modulo int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &serialNumber, &key, &modulo))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, zentralShard(serialNumber, key, modulo)))
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestShardFunctionRun(t *testing.T) {
+	ctx := context.Background()
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{
+			types.StringValue("0123456789"),
+			types.StringValue("key"),
+			types.Int64Value(100),
+		}),
+	}
+	resp := &function.RunResponse{Result: function.NewResultData(types.Int64Unknown())}
+	NewShardFunction().Run(ctx, req, resp)
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+	expected := types.Int64Value(41)
+	if got := resp.Result.Value(); !got.Equal(expected) {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+}
+
+func TestAccShardFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccShardFunctionConfig(0),
+				ExpectError: regexp.MustCompile(`value must be at least 1`),
+			},
+			{
+				Config: testAccShardFunctionConfig(100),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("shard", "41"),
+				),
+			},
+		},
+	})
+}
+
+func testAccShardFunctionConfig(modulo int) string {
+	return fmt.Sprintf(`
+output "shard" {
+  value = provider::zentral::shard("0123456789", "key", %d)
+}
+`, modulo)
+}
@@ -0,0 +1,28 @@
+package provider
+
+import "testing"
+
+// The expected shards are computed with Python, independently of this
+// provider, using the expression of the Zentral shards:
+//
+//	int(hashlib.md5(f"{key}{serial_number}".encode("utf-8")).hexdigest()[:8], 16) % modulo
+func TestZentralShard(t *testing.T) {
+	cases := []struct {
+		serialNumber string
+		key          string
+		modulo       int64
+		expected     int64
+	}{
+		{"0123456789", "key", 100, 41},
+		{"C02ABCDEFGH", "e2c4c5a8-5c4f-4a4d-9a8c-3b8f4e5e9f10", 100, 49},
+		{"C02ABCDEFGH", "e2c4c5a8-5c4f-4a4d-9a8c-3b8f4e5e9f10", 1000, 749},
+		{"ZL9X2K4M7Q", "3f0c1e7a-9b2d-4c8e-a1f5-6d7e8f9a0b1c", 100, 29},
+		{"", "key", 7, 0},
+		{"HW0123456", "1", 1, 0},
+	}
+	for _, c := range cases {
+		if got := zentralShard(c.serialNumber, c.key, c.modulo); got != c.expected {
+			t.Errorf("shard of %q with key %q and modulo %d: expected %d, got %d", c.serialNumber, c.key, c.modulo, c.expected, got)
+		}
+	}
+}
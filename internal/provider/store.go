@@ -1,7 +1,12 @@
 package provider
 
 import (
+	"context"
+
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/zentralopensource/goztl"
 )
@@ -211,6 +216,28 @@ func storeForState(s *goztl.Store) store {
 	}
 }
 
+// storeWithWriteOnlyForState adds the write-only attributes of the backend
+// secrets to the store state, using the write-only versions of the prior data.
+func storeWithWriteOnlyForState(ctx context.Context, data store, prior store) store {
+	data.HTTP = objectWithWriteOnlyForState(ctx, data.HTTP, prior.HTTP, "password")
+	data.Panther = objectWithWriteOnlyForState(ctx, data.Panther, prior.Panther, "bearer_token")
+	data.Splunk = objectWithWriteOnlyForState(ctx, data.Splunk, prior.Splunk, "hec_token")
+	return data
+}
+
+// storeWithWriteOnlyConfig sets the backend secrets of the store plan data
+// to the write-only values of the configuration.
+func storeWithWriteOnlyConfig(ctx context.Context, config tfsdk.Config, data store) (store, diag.Diagnostics) {
+	var diags, d diag.Diagnostics
+	data.HTTP, d = objectWithWriteOnlyConfig(ctx, config, path.Root("http"), data.HTTP, "password")
+	diags.Append(d...)
+	data.Panther, d = objectWithWriteOnlyConfig(ctx, config, path.Root("panther"), data.Panther, "bearer_token")
+	diags.Append(d...)
+	data.Splunk, d = objectWithWriteOnlyConfig(ctx, config, path.Root("splunk"), data.Splunk, "hec_token")
+	diags.Append(d...)
+	return data, diags
+}
+
 func httpBackendWithState(data store) *goztl.StoreHTTP {
 	var b *goztl.StoreHTTP
 	if !data.HTTP.IsNull() {
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -70,7 +71,7 @@ func makeHTTPHeadersSchema(desc string) schema.SetNestedAttribute {
 var httpBackendSchema schema.SingleNestedAttribute = schema.SingleNestedAttribute{
 	Description:         "HTTP backend parameters.",
 	MarkdownDescription: "HTTP backend parameters.",
-	Attributes: map[string]schema.Attribute{
+	Attributes: withWriteOnlySecretAttributes(map[string]schema.Attribute{
 		"endpoint_url": schema.StringAttribute{
 			Description:         "HTTP endpoint URL.",
 			MarkdownDescription: "HTTP endpoint URL.",
@@ -143,7 +144,9 @@ var httpBackendSchema schema.SingleNestedAttribute = schema.SingleNestedAttribut
 			Computed:            true,
 			Default:             booldefault.StaticBool(true),
 		},
-	},
+	}, map[string]string{
+		"password": "Password for basic authentication.",
+	}),
 	Optional: true,
 }
 
@@ -219,17 +222,20 @@ var kinesisBackendSchema schema.SingleNestedAttribute = schema.SingleNestedAttri
 var pantherBackendSchema schema.SingleNestedAttribute = schema.SingleNestedAttribute{
 	Description:         "Panther backend parameters (HTTP log source).",
 	MarkdownDescription: "Panther backend parameters (HTTP log source).",
-	Attributes: map[string]schema.Attribute{
+	Attributes: withWriteOnlySecretAttributes(map[string]schema.Attribute{
 		"endpoint_url": schema.StringAttribute{
 			Description:         "HTTP log source URL.",
 			MarkdownDescription: "HTTP log source URL.",
 			Required:            true,
 		},
 		"bearer_token": schema.StringAttribute{
-			Description:         "Bearer Token.",
-			MarkdownDescription: "Bearer Token.",
-			Required:            true,
+			Description:         "Bearer Token. Exactly one of bearer_token or bearer_token_wo must be set.",
+			MarkdownDescription: "Bearer Token. Exactly one of `bearer_token` or `bearer_token_wo` must be set.",
+			Optional:            true,
 			Sensitive:           true,
+			Validators: []validator.String{
+				stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("bearer_token_wo")),
+			},
 		},
 		"batch_size": schema.Int64Attribute{
 			Description: fmt.Sprintf(
@@ -251,14 +257,16 @@ var pantherBackendSchema schema.SingleNestedAttribute = schema.SingleNestedAttri
 				int64validator.Between(tfStorePantherBackendMinBatchSize, tfStorePantherBackendMaxBatchSize),
 			},
 		},
-	},
+	}, map[string]string{
+		"bearer_token": "Bearer Token.",
+	}),
 	Optional: true,
 }
 
 var splunkBackendSchema schema.SingleNestedAttribute = schema.SingleNestedAttribute{
 	Description:         "Splunk backend parameters.",
 	MarkdownDescription: "Splunk backend parameters.",
-	Attributes: map[string]schema.Attribute{
+	Attributes: withWriteOnlySecretAttributes(map[string]schema.Attribute{
 		// HEC
 		"hec_url": schema.StringAttribute{
 			Description:         "HEC endpoint URL.",
@@ -266,10 +274,13 @@ var splunkBackendSchema schema.SingleNestedAttribute = schema.SingleNestedAttrib
 			Required:            true,
 		},
 		"hec_token": schema.StringAttribute{
-			Description:         "HEC token.",
-			MarkdownDescription: "HEC token.",
-			Required:            true,
+			Description:         "HEC token. Exactly one of hec_token or hec_token_wo must be set.",
+			MarkdownDescription: "HEC token. Exactly one of `hec_token` or `hec_token_wo` must be set.",
+			Optional:            true,
 			Sensitive:           true,
+			Validators: []validator.String{
+				stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("hec_token_wo")),
+			},
 		},
 		"hec_extra_headers": makeHTTPHeadersSchema("HEC"),
 		"hec_request_timeout": schema.Int64Attribute{
@@ -373,7 +384,9 @@ var splunkBackendSchema schema.SingleNestedAttribute = schema.SingleNestedAttrib
 			Computed:            true,
 			Default:             booldefault.StaticBool(true),
 		},
-	},
+	}, map[string]string{
+		"hec_token": "HEC token.",
+	}),
 	Optional: true,
 }
 
@@ -506,7 +519,15 @@ func (r *StoreResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	ztlS, _, err := r.client.Stores.Create(ctx, storeRequestWithState(data))
+	// Read the write-only secrets from the configuration
+	reqData, diags := storeWithWriteOnlyConfig(ctx, req.Config, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ztlS, _, err := r.client.Stores.Create(ctx, storeRequestWithState(reqData))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error",
@@ -518,7 +539,7 @@ func (r *StoreResource) Create(ctx context.Context, req resource.CreateRequest,
 	tflog.Trace(ctx, "created a store")
 
 	// Save data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, storeForState(ztlS))...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, storeWithWriteOnlyForState(ctx, storeForState(ztlS), data))...)
 }
 
 func (r *StoreResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -531,8 +552,13 @@ func (r *StoreResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	ztlS, _, err := r.client.Stores.GetByID(ctx, data.ID.ValueString())
+	ztlS, ztlResp, err := r.client.Stores.GetByID(ctx, data.ID.ValueString())
 	if err != nil {
+		if isNotFound(ztlResp, err) {
+			tflog.Warn(ctx, "Store not found, removing it from the state", map[string]interface{}{"id": data.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Client error",
 			fmt.Sprintf("Unable to read store %s, got error: %s", data.ID.ValueString(), err),
@@ -543,7 +569,7 @@ func (r *StoreResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	tflog.Trace(ctx, "read a store")
 
 	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, storeForState(ztlS))...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, storeWithWriteOnlyForState(ctx, storeForState(ztlS), data))...)
 }
 
 func (r *StoreResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -556,7 +582,15 @@ func (r *StoreResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	ztlS, _, err := r.client.Stores.Update(ctx, data.ID.ValueString(), storeRequestWithState(data))
+	// Read the write-only secrets from the configuration
+	reqData, diags := storeWithWriteOnlyConfig(ctx, req.Config, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ztlS, _, err := r.client.Stores.Update(ctx, data.ID.ValueString(), storeRequestWithState(reqData))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error",
@@ -568,7 +602,7 @@ func (r *StoreResource) Update(ctx context.Context, req resource.UpdateRequest,
 	tflog.Trace(ctx, "updated a store")
 
 	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, storeForState(ztlS))...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, storeWithWriteOnlyForState(ctx, storeForState(ztlS), data))...)
 }
 
 func (r *StoreResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -1,11 +1,13 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/zentralopensource/goztl"
 )
 
 func TestAccStoreResource(t *testing.T) {
@@ -491,6 +493,17 @@ func TestAccStoreResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// Deleted outside of Terraform
+			{
+				Config: testAccStoreResourceConfigSplunkFull(firstName),
+				Check: testAccCheckResourceDisappears(
+					resourceName,
+					func(ctx context.Context, c *goztl.Client, id string) (*goztl.Response, error) {
+						return c.Stores.Delete(ctx, id)
+					},
+				),
+				ExpectNonEmptyPlan: true,
+			},
 		},
 	})
 }
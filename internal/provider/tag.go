@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/zentralopensource/goztl"
 )
@@ -12,6 +13,13 @@ type tag struct {
 	Color      types.String `tfsdk:"color"`
 }
 
+var tagAttrTypes = map[string]attr.Type{
+	"id":          types.Int64Type,
+	"taxonomy_id": types.Int64Type,
+	"name":        types.StringType,
+	"color":       types.StringType,
+}
+
 func tagForState(t *goztl.Tag) tag {
 	var taxonomyID types.Int64
 	if t.TaxonomyID != nil {
@@ -125,8 +125,13 @@ func (r *TagResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		return
 	}
 
-	tag, _, err := r.client.Tags.GetByID(ctx, int(data.ID.ValueInt64()))
+	tag, ztlResp, err := r.client.Tags.GetByID(ctx, int(data.ID.ValueInt64()))
 	if err != nil {
+		if isNotFound(ztlResp, err) {
+			tflog.Warn(ctx, "Tag not found, removing it from the state", map[string]interface{}{"id": data.ID.ValueInt64()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Client error",
 			fmt.Sprintf("Unable to read tag %d, got error: %s", data.ID.ValueInt64(), err),
@@ -195,5 +200,14 @@ func (r *TagResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 }
 
 func (r *TagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resourceImportStatePassthroughZentralID(ctx, "tag", req, resp)
+	resourceImportStateZentralIDOrName(ctx, "tag", req, resp, func(ctx context.Context, name string) (int, error) {
+		obj, _, err := r.client.Tags.GetByName(ctx, name)
+		if err != nil {
+			return 0, err
+		}
+		if obj == nil {
+			return 0, errImportKeyNotFound
+		}
+		return obj.ID, nil
+	})
 }
@@ -1,11 +1,14 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"strconv"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/zentralopensource/goztl"
 )
 
 func TestAccTagResource(t *testing.T) {
@@ -69,6 +72,13 @@ func TestAccTagResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// ImportState by name
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     "name:" + secondName,
+				ImportStateVerify: true,
+			},
 			// Update and Read without taxonomy
 			{
 				Config: testAccTagResourceConfig(txName, secondName, secondColor),
@@ -81,6 +91,21 @@ func TestAccTagResource(t *testing.T) {
 						resourceName, "color", secondColor),
 				),
 			},
+			// Deleted outside of Terraform
+			{
+				Config: testAccTagResourceConfig(txName, secondName, secondColor),
+				Check: testAccCheckResourceDisappears(
+					resourceName,
+					func(ctx context.Context, c *goztl.Client, id string) (*goztl.Response, error) {
+						ztlID, err := strconv.Atoi(id)
+						if err != nil {
+							return nil, err
+						}
+						return c.Tags.Delete(ctx, ztlID)
+					},
+				),
+				ExpectNonEmptyPlan: true,
+			},
 		},
 	})
 }
@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zentralopensource/goztl"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &TagsDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &TagsDataSource{}
+
+func NewTagsDataSource() datasource.DataSource {
+	return &TagsDataSource{}
+}
+
+// TagsDataSource defines the data source implementation.
+type TagsDataSource struct {
+	client *goztl.Client
+}
+
+type tags struct {
+	TaxonomyID types.Int64  `tfsdk:"taxonomy_id"`
+	NamePrefix types.String `tfsdk:"name_prefix"`
+	NameRegex  types.String `tfsdk:"name_regex"`
+	Tags       types.List   `tfsdk:"tags"`
+}
+
+func (d *TagsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tags"
+}
+
+func (d *TagsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := nameFilterAttributes("tags")
+	attributes["taxonomy_id"] = schema.Int64Attribute{
+		Description:         "Only return the tags of the taxonomy with this ID.",
+		MarkdownDescription: "Only return the tags of the taxonomy with this `ID`.",
+		Optional:            true,
+	}
+	attributes["tags"] = schema.ListNestedAttribute{
+		Description:         "List of the tags, sorted by name.",
+		MarkdownDescription: "List of the tags, sorted by name.",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.Int64Attribute{
+					Description:         "ID of the tag.",
+					MarkdownDescription: "`ID` of the tag.",
+					Computed:            true,
+				},
+				"taxonomy_id": schema.Int64Attribute{
+					Description:         "ID of the tag taxonomy.",
+					MarkdownDescription: "`ID` of the tag taxonomy.",
+					Computed:            true,
+				},
+				"name": schema.StringAttribute{
+					Description:         "Name of the tag.",
+					MarkdownDescription: "Name of the tag.",
+					Computed:            true,
+				},
+				"color": schema.StringAttribute{
+					Description:         "Color of the tag.",
+					MarkdownDescription: "Color of the tag.",
+					Computed:            true,
+				},
+			},
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description:         "Allows details of the tags to be retrieved, optionally filtered by taxonomy or name.",
+		MarkdownDescription: "The data source `zentral_tags` allows details of the tags to be retrieved, optionally filtered by taxonomy or name.",
+		Attributes:          attributes,
+	}
+}
+
+func (d *TagsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*goztl.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *goztl.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *TagsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data tags
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, diags := newNameFilter(data.NamePrefix, data.NameRegex)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (d *TagsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data tags
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nf, diags := newNameFilter(data.NamePrefix, data.NameRegex)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ztlTags, err := listAllPages(ctx, d.client.Tags.List)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to list tags, got error: %s", err),
+		)
+		return
+	}
+	sortByName(ztlTags, func(t goztl.Tag) string { return t.Name })
+
+	tagsForState := make([]tag, 0)
+	for i := range ztlTags {
+		t := &ztlTags[i]
+		if !data.TaxonomyID.IsNull() && (t.TaxonomyID == nil || int64(*t.TaxonomyID) != data.TaxonomyID.ValueInt64()) {
+			continue
+		}
+		if !nf.match(t.Name) {
+			continue
+		}
+		tagsForState = append(tagsForState, tagForState(t))
+	}
+
+	data.Tags, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: tagAttrTypes}, tagsForState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
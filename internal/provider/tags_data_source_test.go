@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTagsDataSource(t *testing.T) {
+	prefix := acctest.RandString(12)
+	tResourceName := "zentral_taxonomy.test"
+	t1ResourceName := "zentral_tag.test1"
+	t2ResourceName := "zentral_tag.test2"
+	t3ResourceName := "zentral_tag.test3"
+	dsPrefixResourceName := "data.zentral_tags.by_prefix"
+	dsTaxonomyResourceName := "data.zentral_tags.by_taxonomy"
+	dsRegexResourceName := "data.zentral_tags.by_regex"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTagsDataSourceInvalidRegexConfig(),
+				ExpectError: regexp.MustCompile(`Invalid regular expression`),
+			},
+			{
+				Config: testAccTagsDataSourceConfig(prefix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Filtered by name prefix, sorted by name
+					resource.TestCheckResourceAttr(
+						dsPrefixResourceName, "tags.#", "3"),
+					resource.TestCheckResourceAttrPair(
+						dsPrefixResourceName, "tags.0.id", t1ResourceName, "id"),
+					resource.TestCheckResourceAttrPair(
+						dsPrefixResourceName, "tags.0.taxonomy_id", tResourceName, "id"),
+					resource.TestCheckResourceAttr(
+						dsPrefixResourceName, "tags.0.name", prefix+"a"),
+					resource.TestCheckResourceAttr(
+						dsPrefixResourceName, "tags.0.color", "0079bf"),
+					resource.TestCheckResourceAttrPair(
+						dsPrefixResourceName, "tags.1.id", t2ResourceName, "id"),
+					resource.TestCheckResourceAttrPair(
+						dsPrefixResourceName, "tags.2.id", t3ResourceName, "id"),
+					resource.TestCheckNoResourceAttr(
+						dsPrefixResourceName, "tags.2.taxonomy_id"),
+					// Filtered by taxonomy and name prefix
+					resource.TestCheckResourceAttr(
+						dsTaxonomyResourceName, "tags.#", "2"),
+					resource.TestCheckResourceAttrPair(
+						dsTaxonomyResourceName, "tags.0.id", t1ResourceName, "id"),
+					resource.TestCheckResourceAttrPair(
+						dsTaxonomyResourceName, "tags.1.id", t2ResourceName, "id"),
+					// Filtered by regex
+					resource.TestCheckResourceAttr(
+						dsRegexResourceName, "tags.#", "2"),
+					resource.TestCheckResourceAttrPair(
+						dsRegexResourceName, "tags.0.id", t1ResourceName, "id"),
+					resource.TestCheckResourceAttrPair(
+						dsRegexResourceName, "tags.1.id", t3ResourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTagsDataSourceInvalidRegexConfig() string {
+	return `
+data "zentral_tags" "invalid" {
+  name_regex = "(yolo"
+}
+`
+}
+
+func testAccTagsDataSourceConfig(prefix string) string {
+	return fmt.Sprintf(`
+resource "zentral_taxonomy" "test" {
+  name = %[1]q
+}
+
+resource "zentral_tag" "test1" {
+  taxonomy_id = zentral_taxonomy.test.id
+  name        = "%[1]sa"
+}
+
+resource "zentral_tag" "test2" {
+  taxonomy_id = zentral_taxonomy.test.id
+  name        = "%[1]sb"
+}
+
+resource "zentral_tag" "test3" {
+  name = "%[1]sc"
+}
+
+data "zentral_tags" "by_prefix" {
+  name_prefix = %[1]q
+
+  depends_on = [zentral_tag.test1, zentral_tag.test2, zentral_tag.test3]
+}
+
+data "zentral_tags" "by_taxonomy" {
+  taxonomy_id = zentral_taxonomy.test.id
+  name_prefix = %[1]q
+
+  depends_on = [zentral_tag.test1, zentral_tag.test2, zentral_tag.test3]
+}
+
+data "zentral_tags" "by_regex" {
+  name_regex = "^%[1]s[ac]$"
+
+  depends_on = [zentral_tag.test1, zentral_tag.test2, zentral_tag.test3]
+}
+`, prefix)
+}
@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zentralopensource/goztl"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &TaxonomiesDataSource{}
+
+func NewTaxonomiesDataSource() datasource.DataSource {
+	return &TaxonomiesDataSource{}
+}
+
+// TaxonomiesDataSource defines the data source implementation.
+type TaxonomiesDataSource struct {
+	client *goztl.Client
+}
+
+type taxonomies struct {
+	NamePrefix types.String `tfsdk:"name_prefix"`
+	NameRegex  types.String `tfsdk:"name_regex"`
+	Taxonomies types.List   `tfsdk:"taxonomies"`
+}
+
+func (d *TaxonomiesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_taxonomies"
+}
+
+func (d *TaxonomiesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := nameFilterAttributes("taxonomies")
+	attributes["taxonomies"] = schema.ListNestedAttribute{
+		Description:         "List of the taxonomies, sorted by name.",
+		MarkdownDescription: "List of the taxonomies, sorted by name.",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.Int64Attribute{
+					Description:         "ID of the taxonomy.",
+					MarkdownDescription: "`ID` of the taxonomy.",
+					Computed:            true,
+				},
+				"name": schema.StringAttribute{
+					Description:         "Name of the taxonomy.",
+					MarkdownDescription: "Name of the taxonomy.",
+					Computed:            true,
+				},
+			},
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description:         "Allows details of the taxonomies to be retrieved, optionally filtered by name.",
+		MarkdownDescription: "The data source `zentral_taxonomies` allows details of the taxonomies to be retrieved, optionally filtered by name.",
+		Attributes:          attributes,
+	}
+}
+
+func (d *TaxonomiesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*goztl.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *goztl.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *TaxonomiesDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data taxonomies
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, diags := newNameFilter(data.NamePrefix, data.NameRegex)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (d *TaxonomiesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data taxonomies
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nf, diags := newNameFilter(data.NamePrefix, data.NameRegex)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ztlTaxonomies, err := listAllPages(ctx, d.client.Taxonomies.List)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to list taxonomies, got error: %s", err),
+		)
+		return
+	}
+	sortByName(ztlTaxonomies, func(t goztl.Taxonomy) string { return t.Name })
+
+	taxonomiesForState := make([]taxonomy, 0)
+	for i := range ztlTaxonomies {
+		if nf.match(ztlTaxonomies[i].Name) {
+			taxonomiesForState = append(taxonomiesForState, taxonomyForState(&ztlTaxonomies[i]))
+		}
+	}
+
+	data.Taxonomies, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: taxonomyAttrTypes}, taxonomiesForState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
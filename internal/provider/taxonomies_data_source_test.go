@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTaxonomiesDataSource(t *testing.T) {
+	prefix := acctest.RandString(12)
+	t1ResourceName := "zentral_taxonomy.test1"
+	t2ResourceName := "zentral_taxonomy.test2"
+	dsPrefixResourceName := "data.zentral_taxonomies.by_prefix"
+	dsRegexResourceName := "data.zentral_taxonomies.by_regex"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaxonomiesDataSourceConfig(prefix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Filtered by name prefix, sorted by name
+					resource.TestCheckResourceAttr(
+						dsPrefixResourceName, "taxonomies.#", "2"),
+					resource.TestCheckResourceAttrPair(
+						dsPrefixResourceName, "taxonomies.0.id", t1ResourceName, "id"),
+					resource.TestCheckResourceAttr(
+						dsPrefixResourceName, "taxonomies.0.name", prefix+"a"),
+					resource.TestCheckResourceAttrPair(
+						dsPrefixResourceName, "taxonomies.1.id", t2ResourceName, "id"),
+					resource.TestCheckResourceAttr(
+						dsPrefixResourceName, "taxonomies.1.name", prefix+"b"),
+					// Filtered by regex
+					resource.TestCheckResourceAttr(
+						dsRegexResourceName, "taxonomies.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						dsRegexResourceName, "taxonomies.0.id", t2ResourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTaxonomiesDataSourceConfig(prefix string) string {
+	return fmt.Sprintf(`
+resource "zentral_taxonomy" "test1" {
+  name = "%[1]sa"
+}
+
+resource "zentral_taxonomy" "test2" {
+  name = "%[1]sb"
+}
+
+data "zentral_taxonomies" "by_prefix" {
+  name_prefix = %[1]q
+
+  depends_on = [zentral_taxonomy.test1, zentral_taxonomy.test2]
+}
+
+data "zentral_taxonomies" "by_regex" {
+  name_regex = "^%[1]sb$"
+
+  depends_on = [zentral_taxonomy.test1, zentral_taxonomy.test2]
+}
+`, prefix)
+}
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/zentralopensource/goztl"
 )
@@ -10,6 +11,11 @@ type taxonomy struct {
 	Name types.String `tfsdk:"name"`
 }
 
+var taxonomyAttrTypes = map[string]attr.Type{
+	"id":   types.Int64Type,
+	"name": types.StringType,
+}
+
 func taxonomyForState(t *goztl.Taxonomy) taxonomy {
 	return taxonomy{
 		ID:   types.Int64Value(int64(t.ID)),
@@ -110,8 +110,13 @@ func (r *TaxonomyResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	taxonomy, _, err := r.client.Taxonomies.GetByID(ctx, int(data.ID.ValueInt64()))
+	taxonomy, ztlResp, err := r.client.Taxonomies.GetByID(ctx, int(data.ID.ValueInt64()))
 	if err != nil {
+		if isNotFound(ztlResp, err) {
+			tflog.Warn(ctx, "Taxonomy not found, removing it from the state", map[string]interface{}{"id": data.ID.ValueInt64()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Client error",
 			fmt.Sprintf("Unable to read taxonomy %d, got error: %s", data.ID.ValueInt64(), err),
@@ -176,5 +181,14 @@ func (r *TaxonomyResource) Delete(ctx context.Context, req resource.DeleteReques
 }
 
 func (r *TaxonomyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resourceImportStatePassthroughZentralID(ctx, "taxonomy", req, resp)
+	resourceImportStateZentralIDOrName(ctx, "taxonomy", req, resp, func(ctx context.Context, name string) (int, error) {
+		obj, _, err := r.client.Taxonomies.GetByName(ctx, name)
+		if err != nil {
+			return 0, err
+		}
+		if obj == nil {
+			return 0, errImportKeyNotFound
+		}
+		return obj.ID, nil
+	})
 }
@@ -2,15 +2,33 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"regexp"
+	"slices"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zentralopensource/goztl"
 )
 
+// isNotFound returns true if the Zentral API answered with a 404, meaning
+// that the object has been deleted outside of Terraform.
+func isNotFound(r *goztl.Response, err error) bool {
+	if r != nil && r.Response != nil {
+		return r.StatusCode == http.StatusNotFound
+	}
+	var errResp *goztl.ErrorResponse
+	if errors.As(err, &errResp) && errResp.Response != nil {
+		return errResp.Response.StatusCode == http.StatusNotFound
+	}
+	return false
+}
+
 func resourceImportStatePassthroughZentralID(ctx context.Context, name string, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	ztlID, err := strconv.ParseInt(req.ID, 10, 64)
 	if err != nil {
@@ -23,6 +41,76 @@ func resourceImportStatePassthroughZentralID(ctx context.Context, name string, r
 	}
 }
 
+// errImportKeyNotFound is returned by the import key lookups when no object matches the key.
+var errImportKeyNotFound = errors.New("object not found")
+
+const importNamePrefix = "name:"
+
+// resourceImportStateZentralIDOrName imports a resource using its integer ID,
+// or its name with the name:<name> import ID. The name is resolved to the ID
+// using the lookup function.
+func resourceImportStateZentralIDOrName(ctx context.Context, name string, req resource.ImportStateRequest, resp *resource.ImportStateResponse, lookup func(context.Context, string) (int, error)) {
+	if !strings.HasPrefix(req.ID, importNamePrefix) {
+		resourceImportStatePassthroughZentralID(ctx, name, req, resp)
+		return
+	}
+	objName := strings.TrimPrefix(req.ID, importNamePrefix)
+	if objName == "" {
+		resp.Diagnostics.AddError(
+			"Invalid resource ID",
+			fmt.Sprintf("Zentral %s ID must be an integer or name:<name>", name),
+		)
+		return
+	}
+	ztlID, err := lookup(ctx, objName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to find Zentral %s '%s', got error: %s", name, objName, err),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.Int64Value(int64(ztlID)))...)
+}
+
+// resourceImportStateZentralIDOrKey imports a resource using its integer ID,
+// or a natural key made of the non-empty parts described by the keyFormat,
+// separated by slashes. The key parts are resolved to the ID using the lookup
+// function. The last part of the key can contain slashes.
+func resourceImportStateZentralIDOrKey(ctx context.Context, name string, keyFormat string, req resource.ImportStateRequest, resp *resource.ImportStateResponse, lookup func(context.Context, []string) (int, error)) {
+	if !strings.Contains(req.ID, "/") {
+		resourceImportStatePassthroughZentralID(ctx, name, req, resp)
+		return
+	}
+	partCount := strings.Count(keyFormat, "/") + 1
+	parts := strings.SplitN(req.ID, "/", partCount)
+	if len(parts) != partCount || slices.Contains(parts, "") {
+		resp.Diagnostics.AddError(
+			"Invalid resource ID",
+			fmt.Sprintf("Zentral %s ID must be an integer or %s", name, keyFormat),
+		)
+		return
+	}
+	ztlID, err := lookup(ctx, parts)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to find Zentral %s '%s', got error: %s", name, req.ID, err),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.Int64Value(int64(ztlID)))...)
+}
+
+// parseImportKeyID parses an integer ID found in an import key.
+func parseImportKeyID(attrName string, s string) (int, error) {
+	id, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("%s must be an integer", attrName)
+	}
+	return id, nil
+}
+
 var (
 	uuidMatchRe = regexp.MustCompile(`[\da-f]{8}-[\da-f]{4}-[\da-f]{4}-[\da-f]{4}-[\da-f]{12}`)
 )
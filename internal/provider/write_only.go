@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Secret attributes can be set using a write-only variant, named after the
+// secret attribute with a _wo suffix, to keep the secret out of the state.
+// The write-only values are never persisted, so a companion _wo_version
+// attribute must be changed to trigger an update of the secret.
+
+func writeOnlyAttrName(secret string) string {
+	return secret + "_wo"
+}
+
+func writeOnlyVersionAttrName(secret string) string {
+	return secret + "_wo_version"
+}
+
+// makeWriteOnlySecretAttributes returns the write-only attribute and the
+// write-only version attribute of a secret attribute.
+func makeWriteOnlySecretAttributes(secret string, desc string) map[string]schema.Attribute {
+	woName := writeOnlyAttrName(secret)
+	versionName := writeOnlyVersionAttrName(secret)
+	return map[string]schema.Attribute{
+		woName: schema.StringAttribute{
+			Description: fmt.Sprintf(
+				"%s Write-only alternative to %s, never persisted in the state. Requires %s.",
+				desc, secret, versionName,
+			),
+			MarkdownDescription: fmt.Sprintf(
+				"%s Write-only alternative to `%s`, never persisted in the state. Requires `%s`.",
+				desc, secret, versionName,
+			),
+			Optional:  true,
+			Sensitive: true,
+			WriteOnly: true,
+			Validators: []validator.String{
+				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName(secret)),
+				stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName(versionName)),
+			},
+		},
+		versionName: schema.Int64Attribute{
+			Description: fmt.Sprintf(
+				"Version of the %s value. Must be changed to trigger an update of the write-only value.",
+				woName,
+			),
+			MarkdownDescription: fmt.Sprintf(
+				"Version of the `%s` value. Must be changed to trigger an update of the write-only value.",
+				woName,
+			),
+			Optional: true,
+			Validators: []validator.Int64{
+				int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName(woName)),
+			},
+		},
+	}
+}
+
+// withWriteOnlySecretAttributes returns a copy of the nested attributes,
+// extended with the write-only attributes of the secrets.
+func withWriteOnlySecretAttributes(attributes map[string]schema.Attribute, secretDescs map[string]string) map[string]schema.Attribute {
+	extAttributes := make(map[string]schema.Attribute, len(attributes)+2*len(secretDescs))
+	for name, attribute := range attributes {
+		extAttributes[name] = attribute
+	}
+	for secret, desc := range secretDescs {
+		for name, attribute := range makeWriteOnlySecretAttributes(secret, desc) {
+			extAttributes[name] = attribute
+		}
+	}
+	return extAttributes
+}
+
+// writeOnlyAttrTypes returns a copy of the object attribute types,
+// extended with the write-only attributes of the secrets.
+func writeOnlyAttrTypes(attrTypes map[string]attr.Type, secrets ...string) map[string]attr.Type {
+	extAttrTypes := make(map[string]attr.Type, len(attrTypes)+2*len(secrets))
+	for name, attrType := range attrTypes {
+		extAttrTypes[name] = attrType
+	}
+	for _, secret := range secrets {
+		extAttrTypes[writeOnlyAttrName(secret)] = types.StringType
+		extAttrTypes[writeOnlyVersionAttrName(secret)] = types.Int64Type
+	}
+	return extAttrTypes
+}
+
+// objectWithWriteOnlyForState extends an object built from an API response
+// with the write-only attributes of the secrets. The write-only attributes
+// are always null. The write-only versions are copied from the prior value,
+// and when a write-only version is set, the secret returned by the API is
+// replaced by the prior value of the secret attribute, to keep it out of the
+// state.
+func objectWithWriteOnlyForState(ctx context.Context, obj types.Object, prior types.Object, secrets ...string) types.Object {
+	attrTypes := writeOnlyAttrTypes(obj.AttributeTypes(ctx), secrets...)
+	if obj.IsNull() {
+		return types.ObjectNull(attrTypes)
+	}
+
+	var priorAttrs map[string]attr.Value
+	if !prior.IsNull() && !prior.IsUnknown() {
+		priorAttrs = prior.Attributes()
+	}
+
+	attrs := make(map[string]attr.Value, len(attrTypes))
+	for name, value := range obj.Attributes() {
+		attrs[name] = value
+	}
+	for _, secret := range secrets {
+		attrs[writeOnlyAttrName(secret)] = types.StringNull()
+		version := types.Int64Null()
+		if priorVersion, ok := priorAttrs[writeOnlyVersionAttrName(secret)].(types.Int64); ok && !priorVersion.IsUnknown() {
+			version = priorVersion
+		}
+		attrs[writeOnlyVersionAttrName(secret)] = version
+		if !version.IsNull() {
+			attrs[secret] = priorAttrs[secret]
+		}
+	}
+	return types.ObjectValueMust(attrTypes, attrs)
+}
+
+// objectWithWriteOnlyConfig returns a copy of an object plan value, with the
+// secrets set to the values of their write-only attributes, read from the
+// configuration. The write-only values are only available in the configuration.
+func objectWithWriteOnlyConfig(ctx context.Context, config tfsdk.Config, p path.Path, obj types.Object, secrets ...string) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if obj.IsNull() || obj.IsUnknown() {
+		return obj, diags
+	}
+
+	attrs := make(map[string]attr.Value)
+	for name, value := range obj.Attributes() {
+		attrs[name] = value
+	}
+	for _, secret := range secrets {
+		var wo types.String
+		diags.Append(config.GetAttribute(ctx, p.AtName(writeOnlyAttrName(secret)), &wo)...)
+		if diags.HasError() {
+			return obj, diags
+		}
+		if !wo.IsNull() {
+			attrs[secret] = wo
+		}
+	}
+
+	extObj, objDiags := types.ObjectValue(obj.AttributeTypes(ctx), attrs)
+	diags.Append(objDiags...)
+	return extObj, diags
+}
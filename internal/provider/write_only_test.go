@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var testWriteOnlyAttrTypes = map[string]attr.Type{
+	"username": types.StringType,
+	"password": types.StringType,
+}
+
+func testWriteOnlyObject(password types.String, version types.Int64) types.Object {
+	return types.ObjectValueMust(
+		writeOnlyAttrTypes(testWriteOnlyAttrTypes, "password"),
+		map[string]attr.Value{
+			"username":            types.StringValue("yolo"),
+			"password":            password,
+			"password_wo":         types.StringNull(),
+			"password_wo_version": version,
+		},
+	)
+}
+
+func TestObjectWithWriteOnlyForState(t *testing.T) {
+	ctx := context.Background()
+	apiObj := types.ObjectValueMust(
+		testWriteOnlyAttrTypes,
+		map[string]attr.Value{
+			"username": types.StringValue("yolo"),
+			"password": types.StringValue("fomo"),
+		},
+	)
+
+	cases := []struct {
+		name  string
+		prior types.Object
+		want  types.Object
+	}{
+		{
+			name:  "no prior value",
+			prior: types.ObjectNull(writeOnlyAttrTypes(testWriteOnlyAttrTypes, "password")),
+			want:  testWriteOnlyObject(types.StringValue("fomo"), types.Int64Null()),
+		},
+		{
+			name:  "secret",
+			prior: testWriteOnlyObject(types.StringValue("fomo"), types.Int64Null()),
+			want:  testWriteOnlyObject(types.StringValue("fomo"), types.Int64Null()),
+		},
+		{
+			name:  "write-only secret",
+			prior: testWriteOnlyObject(types.StringNull(), types.Int64Value(2)),
+			want:  testWriteOnlyObject(types.StringNull(), types.Int64Value(2)),
+		},
+		{
+			name:  "write-only secret with default",
+			prior: testWriteOnlyObject(types.StringValue(""), types.Int64Value(1)),
+			want:  testWriteOnlyObject(types.StringValue(""), types.Int64Value(1)),
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := objectWithWriteOnlyForState(ctx, apiObj, c.prior, "password")
+			if !got.Equal(c.want) {
+				t.Fatalf("got %s, want %s", got, c.want)
+			}
+		})
+	}
+}
+
+func TestObjectWithWriteOnlyForStateNull(t *testing.T) {
+	ctx := context.Background()
+	got := objectWithWriteOnlyForState(
+		ctx,
+		types.ObjectNull(testWriteOnlyAttrTypes),
+		testWriteOnlyObject(types.StringNull(), types.Int64Value(1)),
+		"password",
+	)
+	want := types.ObjectNull(writeOnlyAttrTypes(testWriteOnlyAttrTypes, "password"))
+	if !got.Equal(want) {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+}
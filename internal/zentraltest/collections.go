@@ -0,0 +1,128 @@
+package zentraltest
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+	"unicode"
+)
+
+// DefaultCollections returns the collections of the Zentral API endpoints
+// used by the provider. Unknown collections are created on the fly with
+// integer IDs on the first POST request.
+func DefaultCollections() []Collection {
+	return []Collection{
+		// Google Workspace
+		{Path: "google_workspace/connections", IDKind: UUIDID},
+		{Path: "google_workspace/group_tag_mappings", IDKind: UUIDID},
+
+		// inventory
+		{Path: "inventory/jmespath_checks", Versioned: true},
+		{Path: "inventory/meta_business_units"},
+		{Path: "inventory/tags"},
+		{Path: "inventory/taxonomies"},
+
+		// MDM
+		{Path: "mdm/acme_issuers", IDKind: UUIDID, Versioned: true},
+		{Path: "mdm/artifacts", IDKind: UUIDID},
+		{Path: "mdm/blueprint_artifacts"},
+		{Path: "mdm/blueprints"},
+		{Path: "mdm/cert_assets", IDKind: UUIDID, Versioned: true},
+		{Path: "mdm/data_assets", IDKind: UUIDID, Versioned: true},
+		{Path: "mdm/declarations", IDKind: UUIDID, Versioned: true},
+		{Path: "mdm/dep_enrollment_custom_views", IDKind: UUIDID},
+		{Path: "mdm/dep_enrollments", OnCreate: setEnrollmentSecret},
+		{Path: "mdm/dep_virtual_servers"},
+		{Path: "mdm/enrollment_custom_views", IDKind: UUIDID},
+		{Path: "mdm/enterprise_apps", IDKind: UUIDID, Versioned: true},
+		{Path: "mdm/filevault_configs"},
+		{Path: "mdm/location_assets"},
+		{Path: "mdm/locations"},
+		{Path: "mdm/ota_enrollments", OnCreate: setEnrollmentSecret},
+		{Path: "mdm/packages", IDKind: UUIDID, Versioned: true},
+		{Path: "mdm/profiles", IDKind: UUIDID, Versioned: true},
+		{Path: "mdm/provisioning_profiles", IDKind: UUIDID, Versioned: true},
+		{Path: "mdm/push_certificates"},
+		{Path: "mdm/recovery_password_configs"},
+		{Path: "mdm/scep_issuers", IDKind: UUIDID, Versioned: true},
+		{Path: "mdm/software_update_enforcements"},
+		{Path: "mdm/store_apps", IDKind: UUIDID, Versioned: true},
+
+		// Monolith
+		{Path: "monolith/catalogs"},
+		{Path: "monolith/conditions"},
+		{Path: "monolith/enrollments", Versioned: true, OnCreate: setEnrollmentSecret},
+		{Path: "monolith/manifest_catalogs"},
+		{Path: "monolith/manifest_enrollment_packages", Versioned: true},
+		{Path: "monolith/manifest_sub_manifests"},
+		{Path: "monolith/manifests", Versioned: true},
+		{Path: "monolith/repositories"},
+		{Path: "monolith/sub_manifest_pkg_infos"},
+		{Path: "monolith/sub_manifests"},
+
+		// Munki
+		{Path: "munki/configurations", Versioned: true},
+		{Path: "munki/enrollments", Versioned: true, OnCreate: setEnrollmentSecret},
+		{Path: "munki/script_checks", Versioned: true},
+
+		// Osquery
+		{Path: "osquery/atcs"},
+		{Path: "osquery/configuration_packs"},
+		{Path: "osquery/configurations"},
+		{Path: "osquery/enrollments", Versioned: true, OnCreate: setEnrollmentSecret},
+		{Path: "osquery/file_categories"},
+		{Path: "osquery/packs", OnCreate: setSlug, OnUpdate: setSlug},
+		{Path: "osquery/queries", Versioned: true},
+
+		// probes
+		{Path: "probes/actions", IDKind: UUIDID},
+		{Path: "probes/probes"},
+
+		// realms
+		{Path: "realms/realms", IDKind: UUIDID},
+
+		// Santa
+		{Path: "santa/configurations"},
+		{Path: "santa/enrollments", Versioned: true, OnCreate: setEnrollmentSecret},
+		{Path: "santa/rules", Versioned: true},
+
+		// stores
+		{Path: "stores/stores", IDKind: UUIDID},
+	}
+}
+
+// setEnrollmentSecret generates the secret of the enrollment secret object.
+func setEnrollmentSecret(obj map[string]interface{}) {
+	es, ok := obj["secret"].(map[string]interface{})
+	if !ok {
+		es = make(map[string]interface{})
+		obj["secret"] = es
+	}
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	es["secret"] = hex.EncodeToString(b)
+	es["id"] = obj["id"]
+}
+
+// setSlug sets the slug of the object computed from its name,
+// like the Django slugify function.
+func setSlug(obj map[string]interface{}) {
+	name, _ := obj["name"].(string)
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(strings.TrimSpace(name)) {
+		switch {
+		case r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r):
+			b.WriteRune(r)
+			dash = false
+		case r == '-' || unicode.IsSpace(r):
+			if !dash && b.Len() > 0 {
+				b.WriteRune('-')
+				dash = true
+			}
+		}
+	}
+	obj["slug"] = strings.TrimRight(b.String(), "-")
+}
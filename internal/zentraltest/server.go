@@ -0,0 +1,414 @@
+// Package zentraltest provides an in-memory stand-in of the Zentral REST API,
+// served by an httptest server, to run the provider tests without a live
+// Zentral instance.
+//
+// The stand-in implements the generic semantics of the Zentral API endpoints
+// used by goztl:
+//
+//	POST   /api/<app>/<collection>/        create an object
+//	GET    /api/<app>/<collection>/        list the objects, filtered by the query parameters
+//	GET    /api/<app>/<collection>/<id>/   get an object
+//	PUT    /api/<app>/<collection>/<id>/   replace an object
+//	PATCH  /api/<app>/<collection>/<id>/   update some fields of an object
+//	DELETE /api/<app>/<collection>/<id>/   delete an object
+//
+// The objects are stored as decoded JSON documents. Integer or UUID IDs are
+// generated on creation, the versioned objects get a version incremented on
+// each update, and 404 responses are returned for the missing objects.
+package zentraltest
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Token is the API token accepted by the server.
+const Token = "zentraltest"
+
+// IDKind is the kind of ID generated for the objects of a collection.
+type IDKind int
+
+const (
+	// IntID is an auto-incremented integer ID.
+	IntID IDKind = iota
+	// UUIDID is a random UUID.
+	UUIDID
+)
+
+// Collection describes an API endpoint collection.
+type Collection struct {
+	// Path of the collection, relative to the API root, without slashes
+	// at the start and at the end. For example "inventory/tags".
+	Path string
+	// IDKind is the kind of ID generated for the new objects.
+	IDKind IDKind
+	// Versioned objects get a version set to 1 on creation,
+	// and incremented on each update.
+	Versioned bool
+	// Defaults are the default values of the fields missing from the
+	// creation requests.
+	Defaults map[string]interface{}
+	// OnCreate is called with each new object, after the ID generation,
+	// to set the fields computed by Zentral.
+	OnCreate func(obj map[string]interface{})
+	// OnUpdate is called with each updated object, after the version
+	// increment, to update the fields computed by Zentral.
+	OnUpdate func(obj map[string]interface{})
+}
+
+// Server is an in-memory Zentral API.
+type Server struct {
+	*httptest.Server
+
+	mu          sync.Mutex
+	collections map[string]*collection
+}
+
+type collection struct {
+	Collection
+	lastID  int
+	objects map[string]map[string]interface{}
+}
+
+// NewServer starts a server with the DefaultCollections.
+// The caller must call Close when finished.
+func NewServer() *Server {
+	s := &Server{collections: make(map[string]*collection)}
+	for _, c := range DefaultCollections() {
+		s.AddCollection(c)
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+// BaseURL returns the base URL of the API, to use as provider base URL.
+func (s *Server) BaseURL() string {
+	return s.URL + "/api/"
+}
+
+// AddCollection registers a collection, replacing any existing collection
+// with the same path.
+func (s *Server) AddCollection(c Collection) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.collections[c.Path] = &collection{
+		Collection: c,
+		objects:    make(map[string]map[string]interface{}),
+	}
+}
+
+// Create stores a new object in a collection, as if it was created using the
+// API, and returns it. It is used to seed the objects that cannot be created
+// using the API, like the MDM locations.
+func (s *Server) Create(path string, obj map[string]interface{}) map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.collection(path).create(obj)
+}
+
+// Get returns a copy of an object, or nil if it does not exist.
+func (s *Server) Get(path string, id string) map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, ok := s.collections[path]
+	if !ok {
+		return nil
+	}
+	obj, ok := c.objects[id]
+	if !ok {
+		return nil
+	}
+	return copyObject(obj)
+}
+
+// Delete removes an object, as if it was deleted in the Zentral GUI.
+// It returns false if the object does not exist.
+func (s *Server) Delete(path string, id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, ok := s.collections[path]
+	if !ok {
+		return false
+	}
+	if _, ok := c.objects[id]; !ok {
+		return false
+	}
+	delete(c.objects, id)
+	return true
+}
+
+// collection returns the collection registered for the path,
+// or registers a new one with integer IDs.
+func (s *Server) collection(path string) *collection {
+	c, ok := s.collections[path]
+	if !ok {
+		c = &collection{
+			Collection: Collection{Path: path},
+			objects:    make(map[string]map[string]interface{}),
+		}
+		s.collections[path] = c
+	}
+	return c
+}
+
+func (c *collection) newID() interface{} {
+	if c.IDKind == UUIDID {
+		return newUUID()
+	}
+	c.lastID++
+	return c.lastID
+}
+
+func (c *collection) create(obj map[string]interface{}) map[string]interface{} {
+	obj = copyObject(obj)
+	for k, v := range c.Defaults {
+		if _, ok := obj[k]; !ok {
+			obj[k] = v
+		}
+	}
+	obj["id"] = c.newID()
+	if c.Versioned {
+		obj["version"] = 1
+	}
+	now := timestamp()
+	obj["created_at"] = now
+	obj["updated_at"] = now
+	if c.OnCreate != nil {
+		c.OnCreate(obj)
+	}
+	c.objects[idKey(obj["id"])] = obj
+	return copyObject(obj)
+}
+
+func (c *collection) update(id string, changes map[string]interface{}, replace bool) map[string]interface{} {
+	obj := c.objects[id]
+	updated := make(map[string]interface{})
+	if !replace {
+		for k, v := range obj {
+			updated[k] = v
+		}
+	}
+	for k, v := range changes {
+		updated[k] = v
+	}
+	// server managed fields
+	for _, k := range []string{"id", "created_at"} {
+		updated[k] = obj[k]
+	}
+	if c.Versioned {
+		updated["version"] = toInt(obj["version"]) + 1
+	}
+	updated["updated_at"] = timestamp()
+	if c.OnUpdate != nil {
+		c.OnUpdate(updated)
+	}
+	c.objects[id] = updated
+	return copyObject(updated)
+}
+
+func (c *collection) list(filters map[string][]string) []map[string]interface{} {
+	objs := make([]map[string]interface{}, 0)
+	for _, obj := range c.objects {
+		if matchFilters(obj, filters) {
+			objs = append(objs, copyObject(obj))
+		}
+	}
+	sort.Slice(objs, func(i, j int) bool {
+		return lessID(objs[i]["id"], objs[j]["id"])
+	})
+	return objs
+}
+
+// matchFilters returns true if each query parameter matching an object field
+// is equal to the string representation of the field value.
+// The other query parameters, like the pagination ones, are ignored.
+func matchFilters(obj map[string]interface{}, filters map[string][]string) bool {
+	for k, values := range filters {
+		v, ok := obj[k]
+		if !ok || len(values) == 0 {
+			continue
+		}
+		if valueString(v) != values[0] {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != "Token "+Token {
+		writeError(w, http.StatusUnauthorized, "Invalid token.")
+		return
+	}
+
+	p := strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/"), "/")
+	if p == "" || p == strings.Trim(r.URL.Path, "/") {
+		writeError(w, http.StatusNotFound, "Not found.")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if c, ok := s.collections[p]; ok {
+		s.handleCollection(w, r, c)
+		return
+	}
+
+	if i := strings.LastIndex(p, "/"); i >= 0 {
+		if c, ok := s.collections[p[:i]]; ok {
+			s.handleObject(w, r, c, p[i+1:])
+			return
+		}
+	}
+
+	if r.Method == http.MethodPost {
+		// unknown collection
+		s.handleCollection(w, r, s.collection(p))
+		return
+	}
+
+	writeError(w, http.StatusNotFound, "Not found.")
+}
+
+func (s *Server) handleCollection(w http.ResponseWriter, r *http.Request, c *collection) {
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, c.list(r.URL.Query()))
+	case http.MethodPost:
+		obj, ok := readObject(w, r)
+		if !ok {
+			return
+		}
+		writeJSON(w, http.StatusCreated, c.create(obj))
+	default:
+		writeError(w, http.StatusMethodNotAllowed, fmt.Sprintf("Method \"%s\" not allowed.", r.Method))
+	}
+}
+
+func (s *Server) handleObject(w http.ResponseWriter, r *http.Request, c *collection, id string) {
+	obj, ok := c.objects[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Not found.")
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, copyObject(obj))
+	case http.MethodPut, http.MethodPatch:
+		changes, ok := readObject(w, r)
+		if !ok {
+			return
+		}
+		writeJSON(w, http.StatusOK, c.update(id, changes, r.Method == http.MethodPut))
+	case http.MethodDelete:
+		delete(c.objects, id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, fmt.Sprintf("Method \"%s\" not allowed.", r.Method))
+	}
+}
+
+func readObject(w http.ResponseWriter, r *http.Request) (map[string]interface{}, bool) {
+	obj := make(map[string]interface{})
+	dec := json.NewDecoder(r.Body)
+	dec.UseNumber()
+	if err := dec.Decode(&obj); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("JSON parse error - %s", err))
+		return nil, false
+	}
+	return obj, true
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, detail string) {
+	writeJSON(w, status, map[string]string{"detail": detail})
+}
+
+// copyObject returns a deep copy of a decoded JSON object.
+func copyObject(obj map[string]interface{}) map[string]interface{} {
+	return copyValue(obj).(map[string]interface{})
+}
+
+func copyValue(v interface{}) interface{} {
+	switch tv := v.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(tv))
+		for k, e := range tv {
+			m[k] = copyValue(e)
+		}
+		return m
+	case []interface{}:
+		l := make([]interface{}, len(tv))
+		for i, e := range tv {
+			l[i] = copyValue(e)
+		}
+		return l
+	default:
+		return v
+	}
+}
+
+func idKey(id interface{}) string {
+	return valueString(id)
+}
+
+func valueString(v interface{}) string {
+	switch tv := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return tv
+	case bool:
+		return strconv.FormatBool(tv)
+	case int:
+		return strconv.Itoa(tv)
+	case json.Number:
+		return tv.String()
+	case float64:
+		return strconv.FormatFloat(tv, 'f', -1, 64)
+	default:
+		return fmt.Sprintf("%v", tv)
+	}
+}
+
+func toInt(v interface{}) int {
+	i, _ := strconv.Atoi(valueString(v))
+	return i
+}
+
+func lessID(a, b interface{}) bool {
+	ia, erra := strconv.Atoi(valueString(a))
+	ib, errb := strconv.Atoi(valueString(b))
+	if erra == nil && errb == nil {
+		return ia < ib
+	}
+	return valueString(a) < valueString(b)
+}
+
+func timestamp() string {
+	return time.Now().UTC().Format("2006-01-02T15:04:05.000000")
+}
+
+func newUUID() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		panic(err)
+	}
+	b[6] = (b[6] & 0x0f) | 0x40 // version 4
+	b[8] = (b[8] & 0x3f) | 0x80 // variant 10
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
@@ -0,0 +1,218 @@
+package zentraltest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func doRequest(t *testing.T, s *Server, method string, path string, body interface{}, v interface{}) int {
+	var buf bytes.Buffer
+	if body != nil {
+		if err := json.NewEncoder(&buf).Encode(body); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+	req, err := http.NewRequest(method, s.BaseURL()+path, &buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	req.Header.Set("Authorization", "Token "+Token)
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := s.Client().Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer resp.Body.Close()
+	if v != nil && resp.StatusCode != http.StatusNoContent {
+		if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+	return resp.StatusCode
+}
+
+func TestCRUD(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	// create
+	var tag map[string]interface{}
+	status := doRequest(t, s, http.MethodPost, "inventory/tags/", map[string]interface{}{"name": "yolo", "color": "ff0000"}, &tag)
+	if status != http.StatusCreated {
+		t.Fatalf("unexpected status code %d", status)
+	}
+	if tag["id"] != float64(1) || tag["name"] != "yolo" {
+		t.Fatalf("unexpected object %v", tag)
+	}
+
+	// get
+	var got map[string]interface{}
+	if status := doRequest(t, s, http.MethodGet, "inventory/tags/1/", nil, &got); status != http.StatusOK {
+		t.Fatalf("unexpected status code %d", status)
+	}
+	if got["name"] != "yolo" || got["color"] != "ff0000" {
+		t.Fatalf("unexpected object %v", got)
+	}
+
+	// replace
+	got = nil
+	if status := doRequest(t, s, http.MethodPut, "inventory/tags/1/", map[string]interface{}{"name": "fomo"}, &got); status != http.StatusOK {
+		t.Fatalf("unexpected status code %d", status)
+	}
+	if got["id"] != float64(1) || got["name"] != "fomo" || got["color"] != nil {
+		t.Fatalf("unexpected object %v", got)
+	}
+
+	// partial update
+	if status := doRequest(t, s, http.MethodPatch, "inventory/tags/1/", map[string]interface{}{"color": "00ff00"}, &got); status != http.StatusOK {
+		t.Fatalf("unexpected status code %d", status)
+	}
+	if got["name"] != "fomo" || got["color"] != "00ff00" {
+		t.Fatalf("unexpected object %v", got)
+	}
+
+	// delete
+	if status := doRequest(t, s, http.MethodDelete, "inventory/tags/1/", nil, nil); status != http.StatusNoContent {
+		t.Fatalf("unexpected status code %d", status)
+	}
+	for _, method := range []string{http.MethodGet, http.MethodPut, http.MethodDelete} {
+		if status := doRequest(t, s, method, "inventory/tags/1/", map[string]interface{}{}, nil); status != http.StatusNotFound {
+			t.Errorf("%s: unexpected status code %d", method, status)
+		}
+	}
+}
+
+func TestListFilters(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	for i := 0; i < 3; i++ {
+		s.Create("inventory/tags", map[string]interface{}{"name": fmt.Sprintf("tag%d", i), "taxonomy": i % 2})
+	}
+
+	var tags []map[string]interface{}
+	if status := doRequest(t, s, http.MethodGet, "inventory/tags/", nil, &tags); status != http.StatusOK {
+		t.Fatalf("unexpected status code %d", status)
+	}
+	if len(tags) != 3 || tags[0]["name"] != "tag0" || tags[2]["name"] != "tag2" {
+		t.Fatalf("unexpected objects %v", tags)
+	}
+
+	if status := doRequest(t, s, http.MethodGet, "inventory/tags/?name=tag1&page=1", nil, &tags); status != http.StatusOK {
+		t.Fatalf("unexpected status code %d", status)
+	}
+	if len(tags) != 1 || tags[0]["name"] != "tag1" {
+		t.Fatalf("unexpected objects %v", tags)
+	}
+
+	if status := doRequest(t, s, http.MethodGet, "inventory/tags/?taxonomy=0", nil, &tags); status != http.StatusOK {
+		t.Fatalf("unexpected status code %d", status)
+	}
+	if len(tags) != 2 {
+		t.Fatalf("unexpected objects %v", tags)
+	}
+}
+
+func TestUUIDAndVersion(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	var store map[string]interface{}
+	doRequest(t, s, http.MethodPost, "stores/stores/", map[string]interface{}{"name": "yolo"}, &store)
+	id, ok := store["id"].(string)
+	if !ok || len(id) != 36 {
+		t.Fatalf("unexpected UUID %v", store["id"])
+	}
+	if s.Get("stores/stores", id) == nil {
+		t.Fatal("object not found")
+	}
+
+	var rule map[string]interface{}
+	doRequest(t, s, http.MethodPost, "santa/rules/", map[string]interface{}{"policy": 1}, &rule)
+	if rule["version"] != float64(1) {
+		t.Fatalf("unexpected version %v", rule["version"])
+	}
+	doRequest(t, s, http.MethodPut, "santa/rules/1/", map[string]interface{}{"policy": 2, "version": 17}, &rule)
+	if rule["version"] != float64(2) {
+		t.Fatalf("unexpected version %v", rule["version"])
+	}
+}
+
+func TestEnrollmentSecret(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	var enrollment map[string]interface{}
+	doRequest(t, s, http.MethodPost, "santa/enrollments/", map[string]interface{}{
+		"configuration": 1,
+		"secret":        map[string]interface{}{"meta_business_unit": 1},
+	}, &enrollment)
+	secret, ok := enrollment["secret"].(map[string]interface{})
+	if !ok || secret["secret"] == "" || secret["meta_business_unit"] != float64(1) {
+		t.Fatalf("unexpected enrollment secret %v", enrollment["secret"])
+	}
+}
+
+func TestSlug(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	var pack map[string]interface{}
+	doRequest(t, s, http.MethodPost, "osquery/packs/", map[string]interface{}{"name": "Yolo Fomo"}, &pack)
+	if pack["slug"] != "yolo-fomo" {
+		t.Fatalf("unexpected slug %v", pack["slug"])
+	}
+	doRequest(t, s, http.MethodPut, fmt.Sprintf("osquery/packs/%v/", pack["id"]), map[string]interface{}{"name": "Fomo"}, &pack)
+	if pack["slug"] != "fomo" {
+		t.Fatalf("unexpected slug %v", pack["slug"])
+	}
+}
+
+func TestDeleteOutOfBand(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	obj := s.Create("probes/probes", map[string]interface{}{"name": "yolo"})
+	if !s.Delete("probes/probes", fmt.Sprint(obj["id"])) {
+		t.Fatal("object not deleted")
+	}
+	if s.Delete("probes/probes", fmt.Sprint(obj["id"])) {
+		t.Fatal("object deleted twice")
+	}
+	if status := doRequest(t, s, http.MethodGet, "probes/probes/1/", nil, nil); status != http.StatusNotFound {
+		t.Fatalf("unexpected status code %d", status)
+	}
+}
+
+func TestUnknownCollection(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	var obj map[string]interface{}
+	if status := doRequest(t, s, http.MethodGet, "yolo/fomos/", nil, nil); status != http.StatusNotFound {
+		t.Fatalf("unexpected status code %d", status)
+	}
+	if status := doRequest(t, s, http.MethodPost, "yolo/fomos/", map[string]interface{}{"name": "yolo"}, &obj); status != http.StatusCreated {
+		t.Fatalf("unexpected status code %d", status)
+	}
+	if status := doRequest(t, s, http.MethodGet, "yolo/fomos/1/", nil, &obj); status != http.StatusOK {
+		t.Fatalf("unexpected status code %d", status)
+	}
+}
+
+func TestAuthentication(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	resp, err := s.Client().Get(s.BaseURL() + "inventory/tags/")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("unexpected status code %d", resp.StatusCode)
+	}
+}
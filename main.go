@@ -19,10 +19,19 @@ var (
 
 func main() {
 	var debug bool
+	var exportDir string
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
+	flag.StringVar(&exportDir, "export", "", "directory in which to export the existing Zentral objects as Terraform configuration")
 	flag.Parse()
 
+	if exportDir != "" {
+		if err := provider.Export(context.Background(), version, exportDir); err != nil {
+			log.Fatal(err.Error())
+		}
+		return
+	}
+
 	opts := providerserver.ServeOpts{
 		Address: "registry.terraform.io/zentral/zentral",
 		Debug:   debug,